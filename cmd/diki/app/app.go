@@ -5,6 +5,7 @@
 package app
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -14,11 +15,14 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/diki/cmd/internal/slogr"
@@ -87,6 +91,32 @@ e.g. to check compliance of your hyperscaler accounts.`,
 	addRunFlags(runCmd, &opts)
 	rootCmd.AddCommand(runCmd)
 
+	var preflightOpts preflightOptions
+	preflightCmd := &cobra.Command{
+		Use:   "preflight",
+		Short: "Preflight checks that the configured providers can be run.",
+		Long:  "Preflight checks for every configured provider that its clusters are reachable, that the permissions needed by its rulesets are granted and that privileged ops pods can be created.",
+		RunE: func(c *cobra.Command, _ []string) error {
+			return preflightCmd(c.Context(), providerCreateFuncs, preflightOpts, logger)
+		},
+	}
+
+	addPreflightFlags(preflightCmd, &preflightOpts)
+	rootCmd.AddCommand(preflightCmd)
+
+	var cleanupOpts cleanupOptions
+	cleanupCmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Cleanup deletes orphaned ops pods of diki.",
+		Long:  "Cleanup deletes the privileged ops pods created by diki in the clusters of the configured providers, e.g. pods left behind by an interrupted run.",
+		RunE: func(c *cobra.Command, _ []string) error {
+			return cleanupCmd(c.Context(), providerCreateFuncs, cleanupOpts, logger)
+		},
+	}
+
+	addCleanupFlags(cleanupCmd, &cleanupOpts)
+	rootCmd.AddCommand(cleanupCmd)
+
 	var reportOpts reportOptions
 	reportCmd := &cobra.Command{
 		Use:   "report",
@@ -126,6 +156,19 @@ e.g. to check compliance of your hyperscaler accounts.`,
 	addReportDiffFlags(diffCmd, &diffOpts)
 	reportCmd.AddCommand(diffCmd)
 
+	var trendOpts trendOptions
+	trendCmd := &cobra.Command{
+		Use:   "trend",
+		Short: "Report trend shows the development of multiple reports over time.",
+		Long:  "Report trend orders reports by their creation time and shows the development of the status and severity counts per provider and ruleset, newly failing rules and the mean time to remediate findings.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return trendCmd(args, reportOpts, trendOpts, logger)
+		},
+	}
+
+	addReportTrendFlags(trendCmd, &trendOpts)
+	reportCmd.AddCommand(trendCmd)
+
 	var generateDiffOpts generateDiffOptions
 	generateDiffCmd := &cobra.Command{
 		Use:   "diff",
@@ -176,12 +219,25 @@ func addRunFlags(cmd *cobra.Command, opts *runOptions) {
 	cmd.PersistentFlags().StringVar(&opts.rulesetID, "ruleset-id", "", "The id of the ruleset that should be run. If provided --ruleset-version should also be set. If both flags are empty all rulesets for the provider will be run.")
 	cmd.PersistentFlags().StringVar(&opts.rulesetVersion, "ruleset-version", "", "The version of the ruleset that should be run. If provided --ruleset-id should also be set. If both flags are empty all rulesets for the provider will be run.")
 	cmd.PersistentFlags().StringVar(&opts.ruleID, "rule-id", "", "If set only the rule with the provided id will be run.")
+	cmd.PersistentFlags().DurationVar(&opts.timeout, "timeout", 0, "If set bounds the duration of the whole run, e.g. 1h30m. Results of rules finished in time are still reported.")
+	cmd.PersistentFlags().BoolVar(&opts.applyPolicyReports, "apply-policy-reports", false, "If set to true diki applies the results as wgpolicyk8s.io/v1alpha2 PolicyReports and ClusterPolicyReports to the checked clusters. The PolicyReport CRDs have to be installed in the clusters.")
+	addFailFlags(cmd, &opts.failOnStatus, &opts.failOnSeverity, &opts.baseline)
 }
 
 func addReportGenerateFlags(cmd *cobra.Command, opts *generateOptions) {
 	cmd.PersistentFlags().Var(cliflag.NewMapStringString(&opts.distinctBy), "distinct-by", "If set generates a merged report. The keys are the IDs for the providers which the merged report will include and the values are distinct metadata attributes to be used as IDs for the different reports.")
-	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the output report. Format can be one of 'html' or 'json'.")
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the output report. Format can be one of 'html', 'json', 'sarif', 'ckl', 'cklb', 'oscal', 'junit' or 'policyreport'. The 'oscal' format creates NIST OSCAL assessment results. The 'ckl' and 'cklb' formats create a DISA STIG Viewer checklist per provider. If the report contains multiple providers the provider ID is appended to the output file name.")
 	cmd.PersistentFlags().StringVar(&opts.minStatus, "min-status", "Passed", "If set specifies the minimal status that will be included in the generated report. Ordered from lowest to highest priority, Status can be one of 'Passed', 'Skipped', 'Accepted', 'Warning', 'Failed', 'Errored' or 'NotImplemented'")
+	cmd.PersistentFlags().StringVar(&opts.oscalControlMapping, "oscal-control-mapping", "", "Path to a yaml file mapping ruleset IDs and rule IDs to lists of OSCAL control IDs. Only used with the 'oscal' format. Rules that are not mapped are linked to a control with ID '<ruleset-id>-<rule-id>'.")
+	cmd.PersistentFlags().StringVar(&opts.groupByFramework, "group-by-framework", "", fmt.Sprintf("If set groups the rules of the report by the controls of the given compliance framework. Only supported with the 'html' and 'json' formats. Rules of the disa-kubernetes-stig and security-hardened rulesets have built-in mappings to the '%s' frameworks, other frameworks require --framework-mapping.", strings.Join(report.FrameworkIDs(nil), "', '")))
+	cmd.PersistentFlags().StringVar(&opts.frameworkMapping, "framework-mapping", "", "Path to a yaml file mapping framework IDs to control mappings in the format of --oscal-control-mapping, e.g. nist-800-53 to disa-kubernetes-stig to \"242376\" to [sc-8, sc-13]. Mapped rules take precedence over the built-in mappings.")
+	addFailFlags(cmd, &opts.failOnStatus, &opts.failOnSeverity, &opts.baseline)
+}
+
+func addFailFlags(cmd *cobra.Command, failOnStatus *[]string, failOnSeverity *string, baseline *string) {
+	cmd.PersistentFlags().StringSliceVar(failOnStatus, "fail-on-status", nil, fmt.Sprintf("If set diki exits with code %d when the report contains checks with any of the given statuses, e.g. Failed,Errored. Only checks included in the report are considered.", ExitCodeFindings))
+	cmd.PersistentFlags().StringVar(failOnSeverity, "fail-on-severity", "", "If set only checks of rules with at least the given severity are considered findings. Severity can be one of 'Low', 'Medium' or 'High'. If --fail-on-status is not set 'Failed' checks are considered findings.")
+	cmd.PersistentFlags().StringVar(baseline, "baseline", "", "Path to a json report of a previous diki run. If set check targets already reported as findings in the baseline are marked as baseline findings and only new findings are considered by --fail-on-status.")
 }
 
 func addReportDiffFlags(cmd *cobra.Command, opts *diffOptions) {
@@ -190,6 +246,10 @@ func addReportDiffFlags(cmd *cobra.Command, opts *diffOptions) {
 	cmd.PersistentFlags().StringVar(&opts.title, "title", "", "The title of a difference report.")
 }
 
+func addReportTrendFlags(cmd *cobra.Command, opts *trendOptions) {
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the output trend report. Format can be one of 'html' or 'json'.")
+}
+
 func addReportGenerateDiffFlags(cmd *cobra.Command, opts *generateDiffOptions) {
 	cmd.PersistentFlags().Var(cliflag.NewMapStringString(&opts.identityAttributes), "identity-attributes", "The keys are the IDs of the providers that will be present in the generated difference report and the values are metadata attributes to be used as identifiers.")
 }
@@ -325,40 +385,134 @@ func diffCmd(rootOpts reportOptions, opts diffOptions) error {
 	return nil
 }
 
+func trendCmd(args []string, rootOpts reportOptions, opts trendOptions, logger *slog.Logger) error {
+	if len(args) == 0 {
+		return configError(errors.New("trend command requires a minimum of one filepath argument"))
+	}
+
+	if opts.format != "html" && opts.format != "json" {
+		return configError(fmt.Errorf("not supported output format %s. Choose one of 'html' or 'json'", opts.format))
+	}
+
+	var reports []*report.Report
+	for _, arg := range args {
+		rep, err := readReport(arg)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, rep)
+	}
+
+	trend := report.CreateTrend(reports)
+
+	var writer io.Writer = os.Stdout
+	if len(rootOpts.outputPath) > 0 {
+		file, err := os.OpenFile(rootOpts.outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				logger.Error(err.Error())
+			}
+		}()
+		writer = file
+	}
+
+	if opts.format == "json" {
+		data, err := json.Marshal(trend)
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(data)
+		return err
+	}
+
+	htmlRenderer, err := report.NewHTMLRenderer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize renderer: %w", err)
+	}
+	return htmlRenderer.Render(writer, trend)
+}
+
 func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, logger *slog.Logger) error {
 	if len(args) == 0 {
-		return errors.New("generate command requires a minimum of one filepath argument")
+		return configError(errors.New("generate command requires a minimum of one filepath argument"))
 	}
 
 	if len(args) > 1 && len(opts.distinctBy) == 0 {
-		return errors.New("generate command requires a single filepath argument when the distinct-by flag is not set")
+		return configError(errors.New("generate command requires a single filepath argument when the distinct-by flag is not set"))
 	}
 
 	minStatus := rule.Passed
 	if len(opts.minStatus) != 0 {
 		minStatus = rule.Status(opts.minStatus)
 		if !slices.Contains(rule.Statuses(), minStatus) {
-			return fmt.Errorf("not defined status: %s", minStatus)
+			return configError(fmt.Errorf("not defined status: %s", minStatus))
+		}
+	}
+
+	failCondition, err := newFailCondition(opts.failOnStatus, opts.failOnSeverity)
+	if err != nil {
+		return configError(err)
+	}
+
+	var frameworkMappings report.FrameworkMappings
+	if len(opts.groupByFramework) > 0 {
+		if len(opts.distinctBy) > 0 {
+			return configError(errors.New("--group-by-framework is not supported for merged reports"))
+		}
+		if opts.format != "html" && opts.format != "json" {
+			return configError(fmt.Errorf("format %s is not supported with --group-by-framework. Choose one of 'html' or 'json'", opts.format))
+		}
+
+		frameworkMappings, err = readFrameworkMappings(opts.frameworkMapping)
+		if err != nil {
+			return configError(err)
+		}
+		if frameworkIDs := report.FrameworkIDs(frameworkMappings); !slices.Contains(frameworkIDs, opts.groupByFramework) {
+			return configError(fmt.Errorf("no mapping for framework %s found. Choose one of '%s' or provide a mapping with --framework-mapping", opts.groupByFramework, strings.Join(frameworkIDs, "', '")))
 		}
 	}
 
 	var reports []*report.Report
 	for _, arg := range args {
-		fileData, err := os.ReadFile(filepath.Clean(arg))
+		rep, err := readReport(arg)
 		if err != nil {
-			return fmt.Errorf("failed to read file %s: %w", arg, err)
+			return err
 		}
 
-		// TODO: handle report types
-		rep := &report.Report{}
-		if err := json.Unmarshal(fileData, rep); err != nil {
-			return fmt.Errorf("failed to unmarshal data: %w", err)
+		// reports created by Diki versions without summaries
+		if rep.Summary == nil {
+			rep.SetSummary(nil)
 		}
-
 		rep.SetMinStatus(minStatus)
 		reports = append(reports, rep)
 	}
 
+	if len(opts.baseline) > 0 {
+		if len(opts.distinctBy) > 0 {
+			return configError(errors.New("--baseline is not supported for merged reports"))
+		}
+
+		baseline, err := readReport(opts.baseline)
+		if err != nil {
+			return configError(err)
+		}
+		reports[0].ApplyBaseline(baseline)
+	}
+
+	if opts.format == "ckl" || opts.format == "cklb" {
+		if len(opts.distinctBy) > 0 {
+			return configError(fmt.Errorf("format %s is not supported for merged reports", opts.format))
+		}
+		if err := writeChecklists(reports[0], opts.format, rootOpts.outputPath); err != nil {
+			return err
+		}
+		return checkFindings(failCondition, reports...)
+	}
+
 	var writer io.Writer = os.Stdout
 	if len(rootOpts.outputPath) > 0 {
 		file, err := os.OpenFile(rootOpts.outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
@@ -385,6 +539,16 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 		outputReport = mergedReport
 	}
 
+	if len(opts.groupByFramework) > 0 {
+		frameworkReport, err := report.GroupByFramework(reports[0], opts.groupByFramework, frameworkMappings)
+		if err != nil {
+			return err
+		}
+
+		outputReport = frameworkReport
+	}
+
+	var renderer report.Renderer
 	switch opts.format {
 	case "html":
 		htmlRenderer, err := report.NewHTMLRenderer()
@@ -392,18 +556,78 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 			return fmt.Errorf("failed to initialize renderer: %w", err)
 		}
 
-		return htmlRenderer.Render(writer, outputReport)
+		renderer = htmlRenderer
+	case "sarif":
+		renderer = report.NewSARIFRenderer()
+	case "junit":
+		renderer = report.NewJUnitRenderer()
+	case "policyreport":
+		renderer = report.NewPolicyReportRenderer()
+	case "oscal":
+		controlMapping, err := readOSCALControlMapping(opts.oscalControlMapping)
+		if err != nil {
+			return configError(err)
+		}
+
+		renderer = report.NewOSCALRenderer(controlMapping)
 	case "json":
 		data, err := json.Marshal(outputReport)
 		if err != nil {
 			return err
 		}
 
-		_, err = writer.Write(data)
-		return err
+		if _, err := writer.Write(data); err != nil {
+			return err
+		}
 	default:
-		return fmt.Errorf("not supported output format %s. Choose one of 'html' or 'json'", opts.format)
+		return configError(fmt.Errorf("not supported output format %s. Choose one of 'html', 'json', 'sarif', 'ckl', 'cklb', 'oscal', 'junit' or 'policyreport'", opts.format))
+	}
+
+	if renderer != nil {
+		if err := renderer.Render(writer, outputReport); err != nil {
+			return err
+		}
+	}
+
+	return checkFindings(failCondition, reports...)
+}
+
+// writeChecklists writes a DISA STIG Viewer checklist for every provider of the report with DISA STIG results.
+// When checklists for multiple providers are written the provider ID is appended to the name of the output file.
+func writeChecklists(rep *report.Report, format, outputPath string) error {
+	newRenderer := func(providerID string) report.Renderer {
+		if format == "ckl" {
+			return report.NewCKLRenderer(providerID)
+		}
+		return report.NewCKLBRenderer(providerID)
+	}
+
+	providerIDs := report.STIGProviders(rep)
+	switch {
+	case len(providerIDs) == 0:
+		return errors.New("report does not contain DISA STIG results")
+	case len(outputPath) == 0 && len(providerIDs) > 1:
+		return configError(errors.New("--output should be set when checklists for multiple providers are generated"))
+	case len(outputPath) == 0:
+		return newRenderer(providerIDs[0]).Render(os.Stdout, rep)
 	}
+
+	for _, providerID := range providerIDs {
+		var buf bytes.Buffer
+		if err := newRenderer(providerID).Render(&buf, rep); err != nil {
+			return err
+		}
+
+		filePath := outputPath
+		if len(providerIDs) > 1 {
+			ext := filepath.Ext(outputPath)
+			filePath = fmt.Sprintf("%s-%s%s", strings.TrimSuffix(outputPath, ext), providerID, ext)
+		}
+		if err := os.WriteFile(filePath, buf.Bytes(), 0600); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func runCmd(ctx context.Context, providerCreateFuncs map[string]provider.ProviderFromConfigFunc, opts runOptions, logger *slog.Logger) error {
@@ -413,7 +637,13 @@ func runCmd(ctx context.Context, providerCreateFuncs map[string]provider.Provide
 
 	dikiConfig, err := readConfig(opts.configFile)
 	if err != nil {
-		return err
+		return configError(err)
+	}
+
+	if dikiConfig.Output != nil {
+		if err := report.ValidateScoreWeights(scoreWeights(dikiConfig.Output.ScoreWeights)); err != nil {
+			return configError(err)
+		}
 	}
 
 	outputPath := opts.outputPath
@@ -421,105 +651,295 @@ func runCmd(ctx context.Context, providerCreateFuncs map[string]provider.Provide
 		outputPath = dikiConfig.Output.Path
 	}
 
+	failOnStatus, failOnSeverity := opts.failOnStatus, opts.failOnSeverity
+	if dikiConfig.Output != nil {
+		if len(failOnStatus) == 0 {
+			failOnStatus = dikiConfig.Output.FailOnStatus
+		}
+		if len(failOnSeverity) == 0 {
+			failOnSeverity = dikiConfig.Output.FailOnSeverity
+		}
+	}
+
+	failCondition, err := newFailCondition(failOnStatus, failOnSeverity)
+	if err != nil {
+		return configError(err)
+	}
+
+	var baseline *report.Report
+	if len(opts.baseline) > 0 {
+		if baseline, err = readReport(opts.baseline); err != nil {
+			return configError(err)
+		}
+	}
+
 	providers, err := getProvidersFromConfig(dikiConfig, providerCreateFuncs)
 	if err != nil {
-		return err
+		return configError(err)
+	}
+
+	rp := &resultProcessor{
+		outputPath:         outputPath,
+		dikiConfig:         dikiConfig,
+		failCondition:      failCondition,
+		baseline:           baseline,
+		applyPolicyReports: opts.applyPolicyReports,
+		providers:          providers,
+	}
+
+	// signalCtx is only done when the run is interrupted, not when it times out.
+	signalCtx := ctx
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, opts.timeout, fmt.Errorf("run timed out after %s", opts.timeout))
+		defer cancel()
 	}
 
 	if opts.all {
-		var providerResults []provider.ProviderResult
+		var (
+			providerResults []provider.ProviderResult
+			runErr          error
+		)
+		defer cleanupInterruptedRun(signalCtx, providers, logger)
 		for _, p := range providers {
 			res, err := p.RunAll(ctx)
 			if err != nil {
-				return err
-			}
-			providerResults = append(providerResults, res)
-		}
-
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
+				runErr = errors.Join(runErr, fmt.Errorf("provider with id %s errored: %w", p.ID(), err))
 			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
+			if len(res.RulesetResults) > 0 {
+				providerResults = append(providerResults, res)
 			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
 		}
-		return nil
+
+		return rp.process(ctx, providerResults, runErr)
 	}
 
 	p, ok := providers[opts.provider]
 	if !ok {
-		return fmt.Errorf("unknown provider: %s", opts.provider)
+		return configError(fmt.Errorf("unknown provider: %s", opts.provider))
 	}
+	defer cleanupInterruptedRun(signalCtx, map[string]provider.Provider{p.ID(): p}, logger)
 
 	switch {
 	case opts.rulesetID == "" && opts.rulesetVersion == "":
 		// run all rulesets for the provider
 		res, err := p.RunAll(ctx)
-		if err != nil {
-			return err
+		var providerResults []provider.ProviderResult
+		if len(res.RulesetResults) > 0 {
+			providerResults = append(providerResults, res)
 		}
-		providerResults := []provider.ProviderResult{res}
 
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
-			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
-			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
-		}
-		return nil
+		return rp.process(ctx, providerResults, err)
 	case opts.rulesetID != "" && opts.rulesetVersion == "":
-		return errors.New("--ruleset-version should be set along with --ruleset-id")
+		return configError(errors.New("--ruleset-version should be set along with --ruleset-id"))
 	case opts.rulesetID == "" && opts.rulesetVersion != "":
-		return errors.New("--ruleset-id should be set along with --ruleset-version")
+		return configError(errors.New("--ruleset-id should be set along with --ruleset-version"))
 	}
 
 	if opts.ruleID == "" {
 		// run the whole ruleset
 		res, err := p.RunRuleset(ctx, opts.rulesetID, opts.rulesetVersion)
-		if err != nil {
-			return err
+		var providerResults []provider.ProviderResult
+		if len(res.RuleResults) > 0 {
+			providerResults = append(providerResults, provider.ProviderResult{ProviderID: p.ID(), ProviderName: p.Name(), Metadata: p.Metadata(), RulesetResults: []ruleset.RulesetResult{res}})
 		}
-		providerResults := []provider.ProviderResult{{ProviderID: p.ID(), ProviderName: p.Name(), Metadata: p.Metadata(), RulesetResults: []ruleset.RulesetResult{res}}}
 
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
-			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
-			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
+		return rp.process(ctx, providerResults, err)
+	}
+
+	return runRule(ctx, p, rp, opts.rulesetID, opts.rulesetVersion, opts.ruleID)
+}
+
+// resultProcessor processes the results of a diki run.
+type resultProcessor struct {
+	outputPath         string
+	dikiConfig         *config.DikiConfig
+	failCondition      *report.FailCondition
+	baseline           *report.Report
+	applyPolicyReports bool
+	providers          map[string]provider.Provider
+}
+
+// process creates a report containing the given provider results, compares it to the baseline, writes it
+// to the output path, applies it as policy reports and checks it for findings matching the fail condition.
+// Errors that occurred during the run take precedence over findings.
+func (rp *resultProcessor) process(ctx context.Context, providerResults []provider.ProviderResult, runErr error) error {
+	if len(providerResults) == 0 {
+		return ruleErrors(runErr)
+	}
+
+	var reportOpts []report.ReportOption
+	if rp.dikiConfig.Output != nil && len(rp.dikiConfig.Output.MinStatus) > 0 {
+		reportOpts = append(reportOpts, report.MinStatus(rp.dikiConfig.Output.MinStatus))
+	}
+	if rp.dikiConfig.Output != nil && len(rp.dikiConfig.Output.ScoreWeights) > 0 {
+		reportOpts = append(reportOpts, scoreWeights(rp.dikiConfig.Output.ScoreWeights))
+	}
+	if len(rp.dikiConfig.Metadata) > 0 {
+		reportOpts = append(reportOpts, report.Metadata(rp.dikiConfig.Metadata))
+	}
+	rep := report.FromProviderResults(providerResults, reportOpts...)
+	if rp.baseline != nil {
+		rep.ApplyBaseline(rp.baseline)
+	}
+	if len(rp.outputPath) > 0 {
+		if err := rep.WriteToFile(rp.outputPath); err != nil {
+			return errors.Join(ruleErrors(runErr), err)
 		}
+	}
+
+	if rp.applyPolicyReports {
+		if err := rp.applyPolicyReportsToClusters(ctx, rep); err != nil {
+			return errors.Join(ruleErrors(runErr), err)
+		}
+	}
+
+	return errors.Join(ruleErrors(runErr), checkFindings(rp.failCondition, rep))
+}
+
+// applyPolicyReportsToClusters applies the policy reports of every provider to the cluster checked by the provider.
+// Providers checking more than one cluster only apply the check targets of the cluster returned by RESTConfig.
+func (rp *resultProcessor) applyPolicyReportsToClusters(ctx context.Context, rep *report.Report) error {
+	// policy reports should be applied even if the run was cancelled or timed out
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+	defer cancel()
+
+	var err error
+	for _, providerReport := range rep.Providers {
+		clusterProvider, ok := rp.providers[providerReport.ID].(provider.ClusterProvider)
+		if !ok {
+			err = errors.Join(err, fmt.Errorf("provider with id %s does not support policy reports", providerReport.ID))
+			continue
+		}
+
+		c, clientErr := client.New(clusterProvider.RESTConfig(), client.Options{})
+		if clientErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to create client for provider with id %s: %w", providerReport.ID, clientErr))
+			continue
+		}
+
+		if multiClusterProvider, ok := clusterProvider.(provider.MultiClusterProvider); ok {
+			// only targets of the cluster returned by RESTConfig are applied, all other targets belong to clusters without policy reports
+			cluster := multiClusterProvider.RESTConfigCluster()
+			providerReport = providerReport.WithTargets(func(target rule.Target) bool { return target["cluster"] == cluster })
+		}
+
+		singleProviderReport := *rep
+		singleProviderReport.Providers = []report.Provider{providerReport}
+		if applyErr := report.ApplyPolicyReports(ctx, c, report.PolicyReportsFromReport(&singleProviderReport)); applyErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to apply policy reports for provider with id %s: %w", providerReport.ID, applyErr))
+		}
+	}
+	return err
+}
+
+// scoreWeights converts the configured score weights to [report.ScoreWeights].
+func scoreWeights(weights map[string]int) report.ScoreWeights {
+	if len(weights) == 0 {
 		return nil
 	}
 
-	return runRule(ctx, p, opts.rulesetID, opts.rulesetVersion, opts.ruleID)
+	result := make(report.ScoreWeights, len(weights))
+	for severity, weight := range weights {
+		result[rule.SeverityLevel(severity)] = weight
+	}
+	return result
 }
 
-func runRule(ctx context.Context, p provider.Provider, rulesetID, rulesetVersion, ruleID string) error {
-	res, err := p.RunRule(ctx, rulesetID, rulesetVersion, ruleID)
+// newFailCondition returns the fail condition described by the given statuses and severity.
+// It returns nil if neither statuses nor severity are set.
+func newFailCondition(statuses []string, severity string) (*report.FailCondition, error) {
+	if len(statuses) == 0 && len(severity) == 0 {
+		return nil, nil
+	}
+
+	failCondition := &report.FailCondition{
+		MinSeverity: rule.SeverityLevel(severity),
+	}
+	if len(severity) > 0 && !slices.Contains(rule.Severities(), failCondition.MinSeverity) {
+		return nil, fmt.Errorf("not defined severity: %s", severity)
+	}
+
+	if len(statuses) == 0 {
+		statuses = []string{string(rule.Failed)}
+	}
+	for _, s := range statuses {
+		status := rule.Status(s)
+		if !slices.Contains(rule.Statuses(), status) {
+			return nil, fmt.Errorf("not defined status: %s", s)
+		}
+		failCondition.Statuses = append(failCondition.Statuses, status)
+	}
+	return failCondition, nil
+}
+
+// readReport reads a json report of a diki run.
+func readReport(filePath string) (*report.Report, error) {
+	fileData, err := os.ReadFile(filepath.Clean(filePath))
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	// TODO: handle report types
+	rep := &report.Report{}
+	if err := json.Unmarshal(fileData, rep); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+	return rep, nil
+}
+
+// checkFindings returns an error with exit code [ExitCodeFindings]
+// if the reports contain findings matching the fail condition.
+func checkFindings(failCondition *report.FailCondition, reports ...*report.Report) error {
+	if failCondition == nil {
+		return nil
+	}
+
+	var numFindings int
+	for _, rep := range reports {
+		numFindings += rep.NumFindings(*failCondition)
+	}
+	if numFindings > 0 {
+		return &ExitError{Code: ExitCodeFindings, Err: fmt.Errorf("found %d findings matching the fail conditions", numFindings)}
+	}
+	return nil
+}
+
+// runRule runs a single rule and prints its result. The result is checked for
+// findings matching the fail condition in the same way as the results of whole rulesets.
+// runRule runs a single rule and prints its result. Errors of the rule run take precedence over findings,
+// the partial result of an errored rule is still printed and checked for findings.
+func runRule(ctx context.Context, p provider.Provider, rp *resultProcessor, rulesetID, rulesetVersion, ruleID string) error {
+	res, runErr := p.RunRule(ctx, rulesetID, rulesetVersion, ruleID)
+	if len(res.RuleID) == 0 {
+		return ruleErrors(runErr)
 	}
 
 	j, err := json.Marshal(res)
 	if err != nil {
-		return err
+		return errors.Join(ruleErrors(runErr), err)
 	}
 
 	fmt.Print(string(j))
-	return nil
+
+	if rp.failCondition == nil {
+		return ruleErrors(runErr)
+	}
+	providerResults := []provider.ProviderResult{{
+		ProviderID:   p.ID(),
+		ProviderName: p.Name(),
+		Metadata:     p.Metadata(),
+		RulesetResults: []ruleset.RulesetResult{{
+			RulesetID:      rulesetID,
+			RulesetVersion: rulesetVersion,
+			RuleResults:    []rule.RuleResult{res},
+		}},
+	}}
+	rep := report.FromProviderResults(providerResults)
+	if rp.baseline != nil {
+		rep.ApplyBaseline(rp.baseline)
+	}
+	return errors.Join(ruleErrors(runErr), checkFindings(rp.failCondition, rep))
 }
 
 type reportOptions struct {
@@ -534,18 +954,35 @@ type runOptions struct {
 	rulesetID      string
 	rulesetVersion string
 	ruleID         string
+	timeout        time.Duration
+	failOnStatus   []string
+	failOnSeverity string
+	baseline       string
+
+	applyPolicyReports bool
 }
 
 type generateOptions struct {
-	distinctBy map[string]string
-	format     string
-	minStatus  string
+	distinctBy     map[string]string
+	format         string
+	minStatus      string
+	failOnStatus   []string
+	failOnSeverity string
+	baseline       string
+
+	oscalControlMapping string
+	groupByFramework    string
+	frameworkMapping    string
 }
 
 type generateDiffOptions struct {
 	identityAttributes map[string]string
 }
 
+type trendOptions struct {
+	format string
+}
+
 type diffOptions struct {
 	oldReport string
 	newReport string
@@ -568,6 +1005,42 @@ func readConfig(filePath string) (*config.DikiConfig, error) {
 	return c, nil
 }
 
+func readOSCALControlMapping(filePath string) (report.OSCALControlMapping, error) {
+	if len(filePath) == 0 {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	controlMapping := report.OSCALControlMapping{}
+	if err := yaml.Unmarshal(data, &controlMapping); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal OSCAL control mapping: %w", err)
+	}
+
+	return controlMapping, nil
+}
+
+func readFrameworkMappings(filePath string) (report.FrameworkMappings, error) {
+	if len(filePath) == 0 {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	frameworkMappings := report.FrameworkMappings{}
+	if err := yaml.Unmarshal(data, &frameworkMappings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal framework mapping: %w", err)
+	}
+
+	return frameworkMappings, nil
+}
+
 func getProvidersFromConfig(c *config.DikiConfig, providerCreateFuncs map[string]provider.ProviderFromConfigFunc) (map[string]provider.Provider, error) {
 	providers := map[string]provider.Provider{}
 	for _, providerConfig := range c.Providers {
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/diki/cmd/internal/slogr"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/preflight"
+	"github.com/gardener/diki/pkg/provider"
+)
+
+type cleanupOptions struct {
+	configFile  string
+	provider    string
+	instanceIDs []string
+}
+
+func addCleanupFlags(cmd *cobra.Command, opts *cleanupOptions) {
+	cmd.PersistentFlags().StringVar(&opts.configFile, "config", "", "Configuration file for diki containing info about providers and rulesets.")
+	cmd.PersistentFlags().StringVar(&opts.provider, "provider", "", "If set only the clusters of the provider with the given id are cleaned up.")
+	cmd.PersistentFlags().StringSliceVar(&opts.instanceIDs, "instance-id", nil, "If set only the ops pods with the given instance id(s) are deleted.")
+}
+
+type cleanupResult struct {
+	providerID string
+	cluster    string
+	pod        client.ObjectKey
+}
+
+func cleanupCmd(ctx context.Context, providerCreateFuncs map[string]provider.ProviderFromConfigFunc, opts cleanupOptions, logger *slog.Logger) error {
+	// Set logger for controller-runtime clients
+	logf.SetLogger(slogr.NewLogr(logger))
+
+	dikiConfig, err := readConfig(opts.configFile)
+	if err != nil {
+		return configError(err)
+	}
+
+	providers, err := getProvidersFromConfig(dikiConfig, providerCreateFuncs)
+	if err != nil {
+		return configError(err)
+	}
+
+	if len(opts.provider) > 0 {
+		p, ok := providers[opts.provider]
+		if !ok {
+			return configError(fmt.Errorf("unknown provider: %s", opts.provider))
+		}
+		providers = map[string]provider.Provider{p.ID(): p}
+	}
+
+	results, err := cleanupOpsPods(ctx, providers, func(preflight.OpsPodRequirement) ([]string, bool) {
+		return opts.instanceIDs, true
+	}, logger)
+	if writeErr := writeCleanupResults(os.Stdout, results); writeErr != nil {
+		return errors.Join(err, writeErr)
+	}
+	return err
+}
+
+// cleanupInterruptedRun deletes the ops pods created by the rulesets of the given providers
+// if the run was interrupted by a signal. Ops pods of other diki runs are not touched.
+func cleanupInterruptedRun(signalCtx context.Context, providers map[string]provider.Provider, logger *slog.Logger) {
+	if signalCtx.Err() == nil {
+		return
+	}
+
+	logger.Info("run was interrupted, deleting ops pods")
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(signalCtx), time.Minute)
+	defer cancel()
+
+	results, err := cleanupOpsPods(ctx, providers, func(opsPod preflight.OpsPodRequirement) ([]string, bool) {
+		// ops pods without a known instance id could belong to another diki run
+		return opsPod.InstanceIDs, len(opsPod.InstanceIDs) > 0
+	}, logger)
+	for _, result := range results {
+		logger.Info("deleted ops pod", "provider", result.providerID, "cluster", result.cluster, "pod", result.pod.String())
+	}
+	if err != nil {
+		logger.Error("failed to delete ops pods", "error", err)
+	}
+}
+
+// cleanupOpsPods deletes the ops pods in the clusters of the given providers where rulesets create ops pods.
+// The instanceIDs func returns the instance ids of the pods that should be deleted in a cluster, all diki ops pods
+// are deleted if it returns no instance ids. Clusters are skipped if it returns false.
+func cleanupOpsPods(
+	ctx context.Context,
+	providers map[string]provider.Provider,
+	instanceIDs func(opsPod preflight.OpsPodRequirement) ([]string, bool),
+	logger *slog.Logger,
+) ([]cleanupResult, error) {
+	var (
+		results []cleanupResult
+		errs    []error
+	)
+	for _, providerID := range slices.Sorted(maps.Keys(providers)) {
+		preflightProvider, ok := providers[providerID].(provider.PreflightProvider)
+		if !ok {
+			logger.Info("provider does not support cleanup", "provider", providerID)
+			continue
+		}
+
+		for _, requirement := range preflight.MergeRequirements(preflightProvider.PreflightRequirements()) {
+			if requirement.OpsPod == nil {
+				continue
+			}
+			podInstanceIDs, ok := instanceIDs(*requirement.OpsPod)
+			if !ok {
+				continue
+			}
+
+			c, err := client.New(requirement.Config, client.Options{})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("provider %s cluster %s: %w", providerID, requirement.Cluster, err))
+				continue
+			}
+
+			deleted, err := pod.DeletePrivilegedPods(ctx, c, podInstanceIDs...)
+			for _, podKey := range deleted {
+				results = append(results, cleanupResult{providerID: providerID, cluster: requirement.Cluster, pod: podKey})
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("provider %s cluster %s: %w", providerID, requirement.Cluster, err))
+			}
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+func writeCleanupResults(w io.Writer, results []cleanupResult) error {
+	if len(results) == 0 {
+		_, err := fmt.Fprintln(w, "No ops pods were deleted.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROVIDER\tCLUSTER\tNAMESPACE\tNAME")
+	for _, result := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.providerID, result.cluster, result.pod.Namespace, result.pod.Name)
+	}
+	return tw.Flush()
+}
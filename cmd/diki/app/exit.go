@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"errors"
+)
+
+const (
+	// ExitCodeError is the exit code for unexpected errors.
+	ExitCodeError = 1
+	// ExitCodeFindings is the exit code used when a report contains findings
+	// matching the configured fail conditions.
+	ExitCodeFindings = 2
+	// ExitCodeRuleErrors is the exit code used when rules or rulesets could not be run successfully.
+	ExitCodeRuleErrors = 3
+	// ExitCodeConfigError is the exit code used when diki is misconfigured,
+	// e.g. an invalid configuration file or invalid flags are provided.
+	ExitCodeConfigError = 4
+	// ExitCodePreflightFailed is the exit code used when preflight checks found blockers.
+	ExitCodePreflightFailed = 5
+)
+
+// ExitError is an error that determines the exit code of diki.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code that diki should exit with for the given error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return ExitCodeError
+}
+
+func configError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ExitError{Code: ExitCodeConfigError, Err: err}
+}
+
+func ruleErrors(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ExitError{Code: ExitCodeRuleErrors, Err: err}
+}
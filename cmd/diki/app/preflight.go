@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/diki/cmd/internal/slogr"
+	"github.com/gardener/diki/pkg/preflight"
+	"github.com/gardener/diki/pkg/provider"
+)
+
+type preflightOptions struct {
+	configFile string
+	provider   string
+}
+
+func addPreflightFlags(cmd *cobra.Command, opts *preflightOptions) {
+	cmd.PersistentFlags().StringVar(&opts.configFile, "config", "", "Configuration file for diki containing info about providers and rulesets.")
+	cmd.PersistentFlags().StringVar(&opts.provider, "provider", "", "If set only the provider with the given id is checked.")
+}
+
+type preflightResult struct {
+	providerID string
+	preflight.Result
+}
+
+func preflightCmd(ctx context.Context, providerCreateFuncs map[string]provider.ProviderFromConfigFunc, opts preflightOptions, logger *slog.Logger) error {
+	// Set logger for controller-runtime clients
+	logf.SetLogger(slogr.NewLogr(logger))
+
+	dikiConfig, err := readConfig(opts.configFile)
+	if err != nil {
+		return configError(err)
+	}
+
+	providers, err := getProvidersFromConfig(dikiConfig, providerCreateFuncs)
+	if err != nil {
+		return configError(err)
+	}
+
+	if len(opts.provider) > 0 {
+		p, ok := providers[opts.provider]
+		if !ok {
+			return configError(fmt.Errorf("unknown provider: %s", opts.provider))
+		}
+		providers = map[string]provider.Provider{p.ID(): p}
+	}
+
+	var results []preflightResult
+	for _, providerID := range slices.Sorted(maps.Keys(providers)) {
+		preflightProvider, ok := providers[providerID].(provider.PreflightProvider)
+		if !ok {
+			results = append(results, preflightResult{providerID: providerID, Result: preflight.Result{
+				Check:   "Preflight",
+				Status:  preflight.Warning,
+				Message: "Provider does not support preflight checks.",
+			}})
+			continue
+		}
+
+		for _, result := range preflight.Check(ctx, preflightProvider.PreflightRequirements()) {
+			results = append(results, preflightResult{providerID: providerID, Result: result})
+		}
+	}
+
+	if err := writePreflightResults(os.Stdout, results); err != nil {
+		return err
+	}
+
+	var blockers int
+	for _, result := range results {
+		if result.Status == preflight.Failed {
+			blockers++
+		}
+	}
+	if blockers > 0 {
+		return &ExitError{Code: ExitCodePreflightFailed, Err: fmt.Errorf("preflight found %d blocker(s)", blockers)}
+	}
+	return nil
+}
+
+func writePreflightResults(w io.Writer, results []preflightResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROVIDER\tCLUSTER\tCHECK\tSTATUS\tMESSAGE")
+	for _, result := range results {
+		message := strings.Join(strings.Fields(result.Message), " ")
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", result.providerID, result.Cluster, result.Check, result.Status, message)
+	}
+	return tw.Flush()
+}
@@ -6,6 +6,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
@@ -29,6 +30,7 @@ func main() {
 	)
 
 	if err := cmd.ExecuteContext(controllerruntime.SetupSignalHandler()); err != nil {
-		log.Fatal(err)
+		log.Print(err)
+		os.Exit(app.ExitCode(err))
 	}
 }
@@ -4,6 +4,14 @@
 
 package config
 
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // DikiConfig is used to represent Diki configuration file.
 type DikiConfig struct {
 	// Providers is a list of all known providers.
@@ -38,6 +46,10 @@ type RulesetConfig struct {
 	Version string `yaml:"version"`
 	// RuleOptions is used to provide per rule configurations.
 	RuleOptions []RuleOptionsConfig `yaml:"ruleOptions"`
+	// Exceptions are time-bound acceptances of findings reported by the ruleset's rules.
+	Exceptions []ExceptionConfig `yaml:"exceptions,omitempty"`
+	// Plugins are rules implemented by external executables that are added to the ruleset.
+	Plugins []PluginConfig `yaml:"plugins,omitempty"`
 	// Args are ruleset specific arguments that each ruleset should be able to parse.
 	Args any `yaml:"args"`
 }
@@ -48,10 +60,53 @@ type RuleOptionsConfig struct {
 	RuleID string `yaml:"ruleID"`
 	// Skip is the rule's skip configuration.
 	Skip *RuleOptionSkipConfig `yaml:"skip,omitempty"`
+	// Timeout is the max duration of a single rule run.
+	// It overwrites the ruleset wide rule timeout.
+	Timeout *metav1.Duration `yaml:"timeout,omitempty"`
+	// RetryPatterns are additional regular expressions matched against the messages
+	// of errored checks. A rule run with a matching errored check is retried.
+	RetryPatterns []string `yaml:"retryPatterns,omitempty"`
 	// Args are rule specific arguments that each rule should be able to parse.
 	Args any `yaml:"args,omitempty"`
 }
 
+// UnmarshalYAML unmarshals a RuleOptionsConfig and parses its timeout like the durations of ruleset args, e.g. 30s.
+func (o *RuleOptionsConfig) UnmarshalYAML(value *yaml.Node) error {
+	type ruleOptionsConfig RuleOptionsConfig
+
+	var (
+		timeout *yaml.Node
+		node    = *value
+	)
+	if value.Kind == yaml.MappingNode {
+		node.Content = nil
+		for i := 0; i+1 < len(value.Content); i += 2 {
+			if value.Content[i].Value == "timeout" {
+				timeout = value.Content[i+1]
+				continue
+			}
+			node.Content = append(node.Content, value.Content[i], value.Content[i+1])
+		}
+	}
+	if err := node.Decode((*ruleOptionsConfig)(o)); err != nil {
+		return err
+	}
+
+	if timeout == nil {
+		return nil
+	}
+	var rawTimeout string
+	if err := timeout.Decode(&rawTimeout); err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(rawTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout of rule option with rule id %s: %w", o.RuleID, err)
+	}
+	o.Timeout = &metav1.Duration{Duration: duration}
+	return nil
+}
+
 // RuleOptionSkipConfig represents options allowing a rule skip.
 type RuleOptionSkipConfig struct {
 	// Enabled determines if a rule should be skipped or not.
@@ -60,6 +115,60 @@ type RuleOptionSkipConfig struct {
 	Justification string `yaml:"justification"`
 }
 
+// PluginConfig represents a rule implemented by an external executable.
+type PluginConfig struct {
+	// ID is the id of the rule. It must be unique within the ruleset.
+	ID string `yaml:"id"`
+	// Name is the user friendly name of the rule.
+	Name string `yaml:"name"`
+	// Severity is the severity of the rule.
+	Severity string `yaml:"severity"`
+	// Command is the path to the plugin executable.
+	Command string `yaml:"command"`
+	// Args are rule specific arguments that are passed to the plugin executable.
+	Args any `yaml:"args,omitempty"`
+	// MaxRetries is the max number of retries of a failed plugin execution. Defaults to 1.
+	MaxRetries *int `yaml:"maxRetries,omitempty"`
+}
+
+// ExceptionConfig represents a time-bound acceptance of findings.
+// Matching checks are reported as accepted until the exception expires.
+type ExceptionConfig struct {
+	// RuleID is the id of the rule whose findings are accepted.
+	RuleID string `yaml:"ruleID"`
+	// Targets are the target attributes matched against the targets of the rule's checks.
+	// A check target matches if it contains all attributes of any of the targets.
+	// If empty all check targets of the rule are matched.
+	Targets []map[string]string `yaml:"targets,omitempty"`
+	// Justification represents the reason why the findings are accepted.
+	Justification string `yaml:"justification"`
+	// Owner is the person or team responsible for the exception.
+	Owner string `yaml:"owner,omitempty"`
+	// Ticket is a reference to a ticket tracking the exception.
+	Ticket string `yaml:"ticket,omitempty"`
+	// ExpiresAt is the time at which the exception expires, e.g. 2026-12-31T12:00:00Z.
+	// An exception with a date only, e.g. 2026-12-31, expires at the end of that day in UTC.
+	ExpiresAt time.Time `yaml:"expiresAt"`
+}
+
+// UnmarshalYAML unmarshals an ExceptionConfig and sets a date only expiresAt to the end of the day.
+func (e *ExceptionConfig) UnmarshalYAML(value *yaml.Node) error {
+	type exceptionConfig ExceptionConfig
+	if err := value.Decode((*exceptionConfig)(e)); err != nil {
+		return err
+	}
+
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		if value.Content[i].Value != "expiresAt" {
+			continue
+		}
+		if date, err := time.Parse(time.DateOnly, value.Content[i+1].Value); err == nil {
+			e.ExpiresAt = date.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+	}
+	return nil
+}
+
 // OutputConfig represents output configurations.
 type OutputConfig struct {
 	// Path is the location which will be used to write a diki report.
@@ -67,4 +176,12 @@ type OutputConfig struct {
 	Path string `yaml:"path"`
 	// MinStatus is the minimal status that diki will report.
 	MinStatus string `yaml:"minStatus"`
+	// FailOnStatus are the check statuses that are considered findings.
+	// If findings are reported diki exits with a dedicated exit code.
+	FailOnStatus []string `yaml:"failOnStatus,omitempty"`
+	// FailOnSeverity is the minimal rule severity for which findings are considered.
+	FailOnSeverity string `yaml:"failOnSeverity,omitempty"`
+	// ScoreWeights are the weights of rules per severity used to calculate the compliance score.
+	// If not set the default weights High=10, Medium=5 and Low=1 are used.
+	ScoreWeights map[string]int `yaml:"scoreWeights,omitempty"`
 }
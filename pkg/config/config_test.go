@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/diki/pkg/config"
+)
+
+var _ = Describe("config", func() {
+	Describe("RuleOptionsConfig", func() {
+		It("should parse the timeout of a rule option", func() {
+			var ruleOptions []config.RuleOptionsConfig
+			Expect(yaml.Unmarshal([]byte(`
+- ruleID: "242376"
+  timeout: 30s
+  retryPatterns: ["foo"]
+  skip:
+    enabled: true
+- ruleID: "242377"
+`), &ruleOptions)).To(Succeed())
+
+			Expect(ruleOptions).To(Equal([]config.RuleOptionsConfig{
+				{
+					RuleID:        "242376",
+					Timeout:       &metav1.Duration{Duration: 30 * time.Second},
+					RetryPatterns: []string{"foo"},
+					Skip:          &config.RuleOptionSkipConfig{Enabled: true},
+				},
+				{RuleID: "242377"},
+			}))
+
+			data, err := json.Marshal(ruleOptions[0])
+			Expect(err).NotTo(HaveOccurred())
+			var roundTripped config.RuleOptionsConfig
+			Expect(json.Unmarshal(data, &roundTripped)).To(Succeed())
+			Expect(roundTripped.Timeout).To(Equal(&metav1.Duration{Duration: 30 * time.Second}))
+		})
+
+		It("should return an error for an invalid timeout", func() {
+			var ruleOptions []config.RuleOptionsConfig
+			Expect(yaml.Unmarshal([]byte(`
+- ruleID: "242376"
+  timeout: foo
+`), &ruleOptions)).To(MatchError(ContainSubstring("invalid timeout of rule option with rule id 242376")))
+		})
+	})
+})
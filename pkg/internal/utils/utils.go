@@ -6,7 +6,6 @@ package utils
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -19,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/gardener/diki/pkg/kubernetes/config"
+	"github.com/gardener/diki/pkg/kubernetes/containerruntime"
 	"github.com/gardener/diki/pkg/kubernetes/pod"
 	"github.com/gardener/diki/pkg/rule"
 )
@@ -129,7 +129,6 @@ func GetFileStatsByDir(
 // Host sources can be exluded by setting excludeSources.
 func GetMountedFilesStats(
 	ctx context.Context,
-	podExecutorRootPath string,
 	podExecutor pod.PodExecutor,
 	pod corev1.Pod,
 	excludeSources []string,
@@ -139,7 +138,6 @@ func GetMountedFilesStats(
 
 	for _, container := range slices.Concat(pod.Spec.Containers, pod.Spec.InitContainers) {
 		containerStats, err2 := getContainerMountedFileStatResults(ctx,
-			podExecutorRootPath,
 			podExecutor,
 			pod,
 			container,
@@ -158,7 +156,7 @@ func GetMountedFilesStats(
 }
 
 // GetContainerID iterates over the passed container names and tries to find a match in the pod container status.
-// It returns the container ID of the first match.
+// It returns the container ID of the first match, including the container runtime, e.g. containerd://<id>.
 func GetContainerID(pod corev1.Pod, containerNames ...string) (string, error) {
 	for _, containerName := range containerNames {
 		containerStatusIdx := slices.IndexFunc(pod.Status.ContainerStatuses, func(containerStatus corev1.ContainerStatus) bool {
@@ -178,42 +176,44 @@ func GetContainerID(pod corev1.Pod, containerNames ...string) (string, error) {
 			containerID = pod.Status.ContainerStatuses[containerStatusIdx].ContainerID
 		}
 
-		switch {
-		case len(containerID) == 0:
+		if len(containerID) == 0 {
 			return "", fmt.Errorf("container with name %s not (yet) running", containerName)
-		case strings.HasPrefix(containerID, "containerd://"):
-			return strings.Split(containerID, "//")[1], nil
-		default:
+		}
+
+		runtimeName, _, err := containerruntime.ParseContainerID(containerID)
+		if err == nil {
+			_, err = containerruntime.InspectorFor(runtimeName)
+		}
+		if err != nil {
 			return "", fmt.Errorf("cannot handle container with name %s", containerName)
 		}
+		return containerID, nil
 	}
 	return "", fmt.Errorf("container with name in %v not (yet) in status", containerNames)
 }
 
-// GetContainerMounts returns the container mounts of a container
+// GetContainerMounts returns the container mounts of a container. The container ID must include
+// the container runtime, e.g. containerd://<id>, which is used to inspect the container.
 func GetContainerMounts(
 	ctx context.Context,
-	podExecutorRootPath string,
 	podExecutor pod.PodExecutor,
 	containerID string,
 ) ([]config.Mount, error) {
-	commandResult, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf(`%s/usr/local/bin/nerdctl --namespace k8s.io inspect --mode=native %s | jq -r .[0].Spec.mounts`, podExecutorRootPath, containerID))
+	runtimeName, id, err := containerruntime.ParseContainerID(containerID)
 	if err != nil {
 		return nil, err
 	}
 
-	var mounts []config.Mount
-	err = json.Unmarshal([]byte(commandResult), &mounts)
+	inspector, err := containerruntime.InspectorFor(runtimeName)
 	if err != nil {
 		return nil, err
 	}
 
-	return mounts, nil
+	return inspector.Mounts(ctx, podExecutor, id)
 }
 
 func getContainerMountedFileStatResults(
 	ctx context.Context,
-	podExecutorRootPath string,
 	podExecutor pod.PodExecutor,
 	pod corev1.Pod,
 	container corev1.Container,
@@ -229,7 +229,7 @@ func getContainerMountedFileStatResults(
 		return stats, err
 	}
 
-	mounts, err := GetContainerMounts(ctx, podExecutorRootPath, podExecutor, containerID)
+	mounts, err := GetContainerMounts(ctx, podExecutor, containerID)
 	if err != nil {
 		return stats, err
 	}
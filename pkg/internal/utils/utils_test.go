@@ -191,7 +191,7 @@ var _ = Describe("utils", func() {
 			executeReturnString := []string{mounts, destinationStats, mounts}
 			executeReturnError := []error{nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
+			result, err := utils.GetMountedFilesStats(ctx, fakePodExecutor, pod, []string{"/lib/modules"})
 
 			Expect(err).To(BeNil())
 			Expect(result).To(Equal(map[string][]utils.FileStats{"test": {destinationFileStats}}))
@@ -204,7 +204,7 @@ var _ = Describe("utils", func() {
 			executeReturnString := []string{mounts, destinationStats, fooStats, mounts}
 			executeReturnError := []error{nil, nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
+			result, err := utils.GetMountedFilesStats(ctx, fakePodExecutor, pod, []string{"/lib/modules"})
 
 			Expect(err).To(BeNil())
 			Expect(result).To(Equal(map[string][]utils.FileStats{"test": {destinationFileStats, fooFileStats}}))
@@ -217,7 +217,7 @@ var _ = Describe("utils", func() {
 			executeReturnString := []string{mounts, destinationStats, mounts, fooStats}
 			executeReturnError := []error{nil, nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
+			result, err := utils.GetMountedFilesStats(ctx, fakePodExecutor, pod, []string{"/lib/modules"})
 
 			Expect(err).To(BeNil())
 			Expect(result).To(Equal(map[string][]utils.FileStats{"test": {destinationFileStats}, "initTest": {fooFileStats}}))
@@ -230,7 +230,7 @@ var _ = Describe("utils", func() {
 			executeReturnString := []string{mounts, destinationStats, "", "2\n", mounts}
 			executeReturnError := []error{nil, nil, nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
+			result, err := utils.GetMountedFilesStats(ctx, fakePodExecutor, pod, []string{"/lib/modules"})
 
 			Expect(err).To(MatchError("could not find files in /foo"))
 			Expect(result).To(Equal(map[string][]utils.FileStats{"test": {destinationFileStats}}))
@@ -243,7 +243,7 @@ var _ = Describe("utils", func() {
 			executeReturnString := []string{mounts, destinationStats, "", "0\n", mounts}
 			executeReturnError := []error{nil, nil, nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
+			result, err := utils.GetMountedFilesStats(ctx, fakePodExecutor, pod, []string{"/lib/modules"})
 
 			Expect(err).To(BeNil())
 			Expect(result).To(Equal(map[string][]utils.FileStats{"test": {destinationFileStats}}))
@@ -277,7 +277,7 @@ var _ = Describe("utils", func() {
 				executeReturnError  []error
 			)
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
+			result, err := utils.GetMountedFilesStats(ctx, fakePodExecutor, pod, []string{"/lib/modules"})
 
 			Expect(err).To(MatchError("container with name in [foo] not (yet) in status\ncontainer with name bar not (yet) running\ncannot handle container with name baz"))
 			Expect(result).To(Equal(map[string][]utils.FileStats{}))
@@ -287,7 +287,7 @@ var _ = Describe("utils", func() {
 			executeReturnString := []string{mounts, mounts}
 			executeReturnError := []error{errors.New("command error"), nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
+			result, err := utils.GetMountedFilesStats(ctx, fakePodExecutor, pod, []string{"/lib/modules"})
 
 			Expect(err).To(MatchError("command error"))
 			Expect(result).To(Equal(map[string][]utils.FileStats{}))
@@ -300,7 +300,7 @@ var _ = Describe("utils", func() {
 			executeReturnString := []string{mounts, destinationStats, fooStats, mounts}
 			executeReturnError := []error{nil, errors.New("command error"), nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
+			result, err := utils.GetMountedFilesStats(ctx, fakePodExecutor, pod, []string{"/lib/modules"})
 
 			Expect(err).To(MatchError("command error"))
 			Expect(result).To(Equal(map[string][]utils.FileStats{"test": {fooFileStats}}))
@@ -347,17 +347,21 @@ var _ = Describe("utils", func() {
 				Expect(result).To(Equal(expectedID))
 			},
 			Entry("should return correct containerID",
-				[]string{"foo"}, "foo", "containerd://1", "1", BeNil()),
+				[]string{"foo"}, "foo", "containerd://1", "containerd://1", BeNil()),
+			Entry("should return correct containerID when the container runtime is CRI-O",
+				[]string{"foo"}, "foo", "cri-o://1", "cri-o://1", BeNil()),
 			Entry("should return correct containerID when multiple container names are present",
-				[]string{"bar", "foo"}, "foo", "containerd://1", "1", BeNil()),
+				[]string{"bar", "foo"}, "foo", "containerd://1", "containerd://1", BeNil()),
 			Entry("should return correct containerID when searching init container",
-				[]string{"initFoo"}, "", "containerd://2", "2", BeNil()),
+				[]string{"initFoo"}, "", "containerd://2", "containerd://2", BeNil()),
 			Entry("should return error when containerStatus missing",
 				[]string{"foo"}, "test", "containerd://1", "", MatchError("container with name in [foo] not (yet) in status")),
 			Entry("should return error when containerID is empty",
 				[]string{"foo"}, "foo", "", "", MatchError("container with name foo not (yet) running")),
 			Entry("should return error when containerID is not recognized",
 				[]string{"foo"}, "foo", "1", "", MatchError("cannot handle container with name foo")),
+			Entry("should return error when the container runtime is not supported",
+				[]string{"foo"}, "foo", "docker://1", "", MatchError("cannot handle container with name foo")),
 		)
 	})
 
@@ -385,7 +389,7 @@ var _ = Describe("utils", func() {
 		DescribeTable("#MatchCases",
 			func(executeReturnString []string, executeReturnError []error, expectedConfigMounts []config.Mount, errorMatcher gomegatypes.GomegaMatcher) {
 				fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
-				result, err := utils.GetContainerMounts(ctx, "", fakePodExecutor, "")
+				result, err := utils.GetContainerMounts(ctx, fakePodExecutor, "containerd://1")
 
 				Expect(err).To(errorMatcher)
 				Expect(result).To(Equal(expectedConfigMounts))
@@ -397,6 +401,14 @@ var _ = Describe("utils", func() {
 				[]string{mounts}, []error{errors.New("command error")},
 				nil, MatchError("command error")),
 		)
+
+		It("should return error when the container runtime is not supported", func() {
+			fakePodExecutor = fakepod.NewFakePodExecutor(nil, nil)
+			result, err := utils.GetContainerMounts(ctx, fakePodExecutor, "docker://1")
+
+			Expect(err).To(MatchError("container runtime docker is not supported"))
+			Expect(result).To(BeNil())
+		})
 	})
 
 	Describe("#ExceedFilePermissions", func() {
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package containerruntime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gardener/diki/pkg/kubernetes/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+)
+
+const (
+	// Containerd is the name of the containerd container runtime.
+	Containerd = "containerd"
+	// CRIO is the name of the CRI-O container runtime.
+	CRIO = "cri-o"
+)
+
+// Inspector inspects the containers of a container runtime. Commands are executed with
+// the executor of a privileged pod on the node of the inspected containers.
+type Inspector interface {
+	// RootFS returns the host path of the root filesystem of a container.
+	RootFS(ctx context.Context, podExecutor pod.PodExecutor, id string) (string, error)
+	// Mounts returns the mounts of a container.
+	Mounts(ctx context.Context, podExecutor pod.PodExecutor, id string) ([]config.Mount, error)
+}
+
+var inspectors = map[string]*stateInspector{
+	Containerd: {
+		stateRoot: "/run/containerd",
+		stateDir: func(id string) string {
+			return fmt.Sprintf("/run/containerd/io.containerd.runtime.v2.task/k8s.io/%s", id)
+		},
+	},
+	CRIO: {
+		stateRoot: "/run/containers",
+		stateDir: func(id string) string {
+			return fmt.Sprintf("/run/containers/storage/overlay-containers/%s/userdata", id)
+		},
+	},
+}
+
+// ParseContainerID splits the container ID of a container status, e.g. containerd://<id>,
+// into the name of the container runtime and the ID of the container.
+func ParseContainerID(containerID string) (string, string, error) {
+	runtimeName, id, ok := strings.Cut(containerID, "://")
+	if !ok || len(runtimeName) == 0 || len(id) == 0 {
+		return "", "", fmt.Errorf("container id %s is not in format <runtime>://<id>", containerID)
+	}
+	return runtimeName, id, nil
+}
+
+// InspectorFor returns the Inspector of the container runtime with the given name.
+func InspectorFor(runtimeName string) (Inspector, error) {
+	inspector, ok := inspectors[runtimeName]
+	if !ok {
+		return nil, fmt.Errorf("container runtime %s is not supported", runtimeName)
+	}
+	return inspector, nil
+}
+
+// StateRoots returns the root directories of the container states of all supported container runtimes.
+func StateRoots() []string {
+	stateRoots := make([]string, 0, len(inspectors))
+	for _, inspector := range inspectors {
+		stateRoots = append(stateRoots, inspector.stateRoot)
+	}
+	slices.Sort(stateRoots)
+	return stateRoots
+}
+
+// stateInspector reads the OCI runtime spec of a container from the state directory of the container runtime.
+// It falls back to crictl inspect if the spec is not found there, e.g. because the runtime uses another state directory.
+type stateInspector struct {
+	// stateRoot is the root directory of the container states of the runtime.
+	stateRoot string
+	// stateDir returns the directory with the config.json of a container.
+	stateDir func(id string) string
+}
+
+// RootFS returns the root path of the runtime spec. Relative paths are resolved against the state directory.
+func (si *stateInspector) RootFS(ctx context.Context, podExecutor pod.PodExecutor, id string) (string, error) {
+	rootPath, err := podExecutor.Execute(ctx, "/bin/sh", si.command(id, ".root.path"))
+	if err != nil {
+		return "", err
+	}
+
+	rootPath = strings.TrimSpace(rootPath)
+	if len(rootPath) == 0 || rootPath == "null" {
+		return "", fmt.Errorf("container %s has no root path", id)
+	}
+	if !path.IsAbs(rootPath) {
+		rootPath = path.Join(si.stateDir(id), rootPath)
+	}
+	return rootPath, nil
+}
+
+// Mounts returns the mounts of the runtime spec.
+func (si *stateInspector) Mounts(ctx context.Context, podExecutor pod.PodExecutor, id string) ([]config.Mount, error) {
+	commandResult, err := podExecutor.Execute(ctx, "/bin/sh", si.command(id, ".mounts"))
+	if err != nil {
+		return nil, err
+	}
+
+	var mounts []config.Mount
+	if err := json.Unmarshal([]byte(commandResult), &mounts); err != nil {
+		return nil, err
+	}
+	return mounts, nil
+}
+
+// command returns a shell command that prints the result of the jq filter applied to the runtime spec of a container.
+// It fails with "container <id> not found" if neither the runtime state nor crictl know the container.
+func (si *stateInspector) command(id, filter string) string {
+	return fmt.Sprintf(`config=%[1]s/config.json
+if [ -f "$config" ]; then
+  jq -r '%[3]s' "$config"
+elif spec=$(crictl inspect -o json %[2]s 2>/dev/null | jq -e '.info.runtimeSpec'); then
+  echo "$spec" | jq -r '%[3]s'
+else
+  echo "container %[2]s not found" >&2
+  exit 1
+fi`, si.stateDir(id), id, filter)
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package containerruntime_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/gardener/diki/pkg/kubernetes/config"
+	"github.com/gardener/diki/pkg/kubernetes/containerruntime"
+)
+
+var _ = Describe("containerruntime", func() {
+	var ctx = context.TODO()
+
+	DescribeTable("#ParseContainerID",
+		func(containerID, expectedRuntimeName, expectedID string, errorMatcher gomegatypes.GomegaMatcher) {
+			runtimeName, id, err := containerruntime.ParseContainerID(containerID)
+
+			Expect(err).To(errorMatcher)
+			Expect(runtimeName).To(Equal(expectedRuntimeName))
+			Expect(id).To(Equal(expectedID))
+		},
+		Entry("should parse containerd container ids", "containerd://foo", "containerd", "foo", BeNil()),
+		Entry("should parse CRI-O container ids", "cri-o://foo", "cri-o", "foo", BeNil()),
+		Entry("should return error when the runtime is missing", "foo", "", "", MatchError("container id foo is not in format <runtime>://<id>")),
+		Entry("should return error when the id is missing", "containerd://", "", "", MatchError("container id containerd:// is not in format <runtime>://<id>")),
+	)
+
+	Describe("#StateRoots", func() {
+		It("should return the state roots of all supported runtimes", func() {
+			Expect(containerruntime.StateRoots()).To(Equal([]string{"/run/containerd", "/run/containers"}))
+		})
+	})
+
+	Describe("#InspectorFor", func() {
+		It("should return error when the runtime is not supported", func() {
+			_, err := containerruntime.InspectorFor("docker")
+			Expect(err).To(MatchError("container runtime docker is not supported"))
+		})
+	})
+
+	DescribeTable("#Mounts",
+		func(runtimeName, expectedStateDir string) {
+			inspector, err := containerruntime.InspectorFor(runtimeName)
+			Expect(err).NotTo(HaveOccurred())
+
+			podExecutor := &recordingPodExecutor{output: `[{"destination": "/foo", "source": "/bar", "type": "bind", "options": ["rbind"]}]`}
+			mounts, err := inspector.Mounts(ctx, podExecutor, "1")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mounts).To(Equal([]config.Mount{{Destination: "/foo", Source: "/bar", Type: "bind", Options: []string{"rbind"}}}))
+			Expect(podExecutor.commandArgs).To(ConsistOf(And(
+				ContainSubstring("config="+expectedStateDir+"/config.json"),
+				ContainSubstring("jq -r '.mounts'"),
+				ContainSubstring("crictl inspect -o json 1"),
+			)))
+		},
+		Entry("should read the containerd state", "containerd", "/run/containerd/io.containerd.runtime.v2.task/k8s.io/1"),
+		Entry("should read the CRI-O state", "cri-o", "/run/containers/storage/overlay-containers/1/userdata"),
+	)
+
+	DescribeTable("#RootFS",
+		func(runtimeName, output, expectedRootFS string, errorMatcher gomegatypes.GomegaMatcher) {
+			inspector, err := containerruntime.InspectorFor(runtimeName)
+			Expect(err).NotTo(HaveOccurred())
+
+			rootFS, err := inspector.RootFS(ctx, &recordingPodExecutor{output: output}, "1")
+
+			Expect(err).To(errorMatcher)
+			Expect(rootFS).To(Equal(expectedRootFS))
+		},
+		Entry("should resolve relative root paths against the state directory", "containerd", "rootfs\n",
+			"/run/containerd/io.containerd.runtime.v2.task/k8s.io/1/rootfs", BeNil()),
+		Entry("should return absolute root paths", "cri-o", "/var/lib/containers/storage/overlay/2/merged\n",
+			"/var/lib/containers/storage/overlay/2/merged", BeNil()),
+		Entry("should return error when there is no root path", "cri-o", "null\n",
+			"", MatchError("container 1 has no root path")),
+	)
+
+	It("should return error when the command errors", func() {
+		inspector, err := containerruntime.InspectorFor("containerd")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = inspector.Mounts(ctx, &recordingPodExecutor{err: errors.New("foo")}, "1")
+		Expect(err).To(MatchError("foo"))
+	})
+})
+
+// recordingPodExecutor records the executed commands and returns the same result for all of them.
+type recordingPodExecutor struct {
+	output      string
+	err         error
+	commandArgs []string
+}
+
+func (r *recordingPodExecutor) Execute(_ context.Context, _ string, commandArg string) (string, error) {
+	r.commandArgs = append(r.commandArgs, commandArg)
+	return r.output, r.err
+}
+
+func (r *recordingPodExecutor) Pod() types.NamespacedName {
+	return types.NamespacedName{}
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/gardener/diki/pkg/kubernetes/pod"
 )
@@ -28,8 +29,8 @@ func NewFakeSimplePodContext(executeReturnString [][]string, executeReturnError
 	}
 }
 
-// Create returns the preset values.
-func (mspc *FakeSimplePodContext) Create(_ context.Context, _ func() *corev1.Pod) (pod.PodExecutor, error) {
+// Create returns the preset values. The returned PodExecutor reports the constructed pod.
+func (mspc *FakeSimplePodContext) Create(_ context.Context, podConstructorFn func() *corev1.Pod) (pod.PodExecutor, error) {
 	if mspc.createCount >= len(mspc.executeReturnString) {
 		return nil, errors.New("not enough return strings have been faked")
 	}
@@ -37,7 +38,10 @@ func (mspc *FakeSimplePodContext) Create(_ context.Context, _ func() *corev1.Pod
 		return nil, errors.New("not enough return errors have been faked")
 	}
 	mspc.createCount++
-	return NewFakePodExecutor(mspc.executeReturnString[mspc.createCount-1], mspc.executeReturnError[mspc.createCount-1]), nil
+	constructedPod := podConstructorFn()
+	executor := NewFakePodExecutor(mspc.executeReturnString[mspc.createCount-1], mspc.executeReturnError[mspc.createCount-1])
+	executor.pod = types.NamespacedName{Namespace: constructedPod.Namespace, Name: constructedPod.Name}
+	return executor, nil
 }
 
 // Delete always returns nil.
@@ -50,6 +54,7 @@ type FakePodExecutor struct {
 	executeReturnString []string
 	executeReturnError  []error
 	executeCount        int
+	pod                 types.NamespacedName
 }
 
 // NewFakePodExecutor creates a new FakePodExecutor.
@@ -72,3 +77,8 @@ func (mpe *FakePodExecutor) Execute(_ context.Context, _ string, _ string) (stri
 	mpe.executeCount++
 	return mpe.executeReturnString[mpe.executeCount-1], mpe.executeReturnError[mpe.executeCount-1]
 }
+
+// Pod returns the pod created by the FakeSimplePodContext.
+func (mpe *FakePodExecutor) Pod() types.NamespacedName {
+	return mpe.pod
+}
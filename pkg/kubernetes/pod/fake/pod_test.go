@@ -11,6 +11,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
 )
@@ -26,8 +28,11 @@ var _ = Describe("pod", func() {
 			executeReturnError := [][]error{{nil}, {errors.New("error")}}
 			mspc := fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 
-			mpe1, err := mspc.Create(ctx, func() *corev1.Pod { return &corev1.Pod{} })
+			mpe1, err := mspc.Create(ctx, func() *corev1.Pod {
+				return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			})
 			Expect(err).To(BeNil())
+			Expect(mpe1.Pod()).To(Equal(types.NamespacedName{Namespace: "bar", Name: "foo"}))
 
 			returnString, returnError := mpe1.Execute(ctx, "", "")
 
@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Reclaimer frees capacity by deleting pods that are not in use.
+type Reclaimer interface {
+	// Reclaim deletes a pod that is not in use. If a node name is given the pod must be on this node.
+	// It returns false if there is no such pod.
+	Reclaim(ctx context.Context, nodeName string) bool
+}
+
+// LimitedPodContext limits the number of pods that exist at the same time in a cluster and on a single node.
+// It is meant to be shared by all users of a cluster. Calls to Create block until there is capacity for the pod. Waiting callers are served in the order of their calls,
+// a caller only waits for callers before it when they wait for capacity on the same node or in the whole cluster.
+// The capacity of a pod is released when it is deleted with Delete.
+type LimitedPodContext struct {
+	podContext PodContext
+	// MaxPods is the max number of pods in the cluster. There is no limit if it is not positive.
+	MaxPods int
+	// MaxPodsPerNode is the max number of pods on a node. There is no limit if it is not positive.
+	// Only pods scheduled to a specific node count against the limit of the node.
+	MaxPodsPerNode int
+	// WaitInterval is the time between attempts to reclaim capacity.
+	WaitInterval time.Duration
+	logger       *slog.Logger
+
+	mu sync.Mutex
+	// reclaimers are asked to free capacity while callers wait.
+	reclaimers []Reclaimer
+	pods       map[types.NamespacedName]string
+	nodePods   map[string]int
+	waiters    []*podWaiter
+}
+
+var _ PodContext = &LimitedPodContext{}
+
+type podWaiter struct {
+	pod      types.NamespacedName
+	nodeName string
+	// granted is closed when the pod was given capacity.
+	granted chan struct{}
+}
+
+// NewLimitedPodContext creates a new LimitedPodContext that creates and deletes pods with the given PodContext.
+func NewLimitedPodContext(podContext PodContext, maxPods, maxPodsPerNode int, logger *slog.Logger) (*LimitedPodContext, error) {
+	return &LimitedPodContext{
+		podContext:     podContext,
+		MaxPods:        maxPods,
+		MaxPodsPerNode: maxPodsPerNode,
+		WaitInterval:   2 * time.Second,
+		logger:         logger,
+		pods:           map[types.NamespacedName]string{},
+		nodePods:       map[string]int{},
+	}, nil
+}
+
+// AddReclaimer adds a Reclaimer that is asked to free capacity while callers wait,
+// e.g. a [PooledPodContext] that keeps idle pods.
+func (lpc *LimitedPodContext) AddReclaimer(reclaimer Reclaimer) {
+	lpc.mu.Lock()
+	defer lpc.mu.Unlock()
+
+	lpc.reclaimers = append(lpc.reclaimers, reclaimer)
+}
+
+// Create waits until there is capacity for the constructed pod and creates it.
+// The capacity is released again if the pod could not be created.
+func (lpc *LimitedPodContext) Create(ctx context.Context, podConstructorFn func() *corev1.Pod) (PodExecutor, error) {
+	pod := podConstructorFn()
+	key := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+
+	if err := lpc.acquire(ctx, key, pod.Spec.NodeSelector[corev1.LabelHostname]); err != nil {
+		return nil, err
+	}
+	executor, err := lpc.podContext.Create(ctx, func() *corev1.Pod { return pod })
+	if err != nil {
+		lpc.release(key)
+		return nil, err
+	}
+	return executor, nil
+}
+
+// Delete deletes a pod and releases its capacity.
+func (lpc *LimitedPodContext) Delete(ctx context.Context, name, namespace string) error {
+	defer lpc.release(types.NamespacedName{Namespace: namespace, Name: name})
+
+	return lpc.podContext.Delete(ctx, name, namespace)
+}
+
+func (lpc *LimitedPodContext) acquire(ctx context.Context, key types.NamespacedName, nodeName string) error {
+	waiter := &podWaiter{pod: key, nodeName: nodeName, granted: make(chan struct{})}
+
+	lpc.mu.Lock()
+	if _, ok := lpc.pods[key]; ok {
+		lpc.mu.Unlock()
+		return nil
+	}
+	lpc.waiters = append(lpc.waiters, waiter)
+	lpc.grant()
+	lpc.mu.Unlock()
+
+	select {
+	case <-waiter.granted:
+		return nil
+	default:
+	}
+
+	start := time.Now()
+	for {
+		if reclaimNodeName, ok := lpc.shouldReclaim(waiter); ok {
+			lpc.reclaim(ctx, reclaimNodeName)
+		}
+
+		select {
+		case <-waiter.granted:
+			lpc.logger.Info("waited for ops pod capacity", "pod", key.String(), "node", nodeName, "duration", time.Since(start).String())
+			return nil
+		case <-ctx.Done():
+			lpc.mu.Lock()
+			defer lpc.mu.Unlock()
+
+			select {
+			case <-waiter.granted:
+				lpc.remove(key)
+			default:
+				lpc.waiters = slices.DeleteFunc(lpc.waiters, func(w *podWaiter) bool { return w == waiter })
+			}
+			// callers after this one might fit now
+			lpc.grant()
+			return ctx.Err()
+		case <-time.After(lpc.WaitInterval):
+		}
+	}
+}
+
+// shouldReclaim returns whether a waiting caller should reclaim capacity and the node of the capacity.
+// Only the first caller that waits for capacity on a node or in the cluster reclaims it, so that
+// no more pods are reclaimed than needed.
+func (lpc *LimitedPodContext) shouldReclaim(waiter *podWaiter) (string, bool) {
+	lpc.mu.Lock()
+	defer lpc.mu.Unlock()
+
+	for _, w := range lpc.waiters {
+		switch {
+		case w == waiter && lpc.nodeFull(w.nodeName):
+			return w.nodeName, true
+		case w == waiter:
+			return "", true
+		case len(w.nodeName) > 0 && w.nodeName == waiter.nodeName:
+			return "", false
+		case !lpc.nodeFull(w.nodeName) && !lpc.nodeFull(waiter.nodeName):
+			return "", false
+		}
+	}
+	return "", false
+}
+
+// reclaim asks the reclaimers to free capacity until one of them deleted a pod.
+func (lpc *LimitedPodContext) reclaim(ctx context.Context, nodeName string) {
+	lpc.mu.Lock()
+	reclaimers := slices.Clone(lpc.reclaimers)
+	lpc.mu.Unlock()
+
+	for _, reclaimer := range reclaimers {
+		if reclaimer.Reclaim(ctx, nodeName) {
+			return
+		}
+	}
+}
+
+func (lpc *LimitedPodContext) release(key types.NamespacedName) {
+	lpc.mu.Lock()
+	defer lpc.mu.Unlock()
+
+	if _, ok := lpc.pods[key]; !ok {
+		return
+	}
+	lpc.remove(key)
+	lpc.grant()
+}
+
+// grant gives capacity to the waiting callers in the order of their calls. Callers that wait for capacity
+// on a full node are skipped, while callers after one that waits for capacity in the full cluster are not served.
+// It must be called with the lock held.
+func (lpc *LimitedPodContext) grant() {
+	var (
+		waiting     []*podWaiter
+		clusterFull bool
+	)
+	for _, waiter := range lpc.waiters {
+		if !clusterFull && lpc.MaxPods > 0 && len(lpc.pods) >= lpc.MaxPods {
+			clusterFull = true
+		}
+		if clusterFull || lpc.nodeFull(waiter.nodeName) {
+			waiting = append(waiting, waiter)
+			continue
+		}
+
+		lpc.pods[waiter.pod] = waiter.nodeName
+		if len(waiter.nodeName) > 0 {
+			lpc.nodePods[waiter.nodeName]++
+		}
+		close(waiter.granted)
+	}
+	lpc.waiters = waiting
+}
+
+// nodeFull returns whether a node has no capacity left. It must be called with the lock held.
+func (lpc *LimitedPodContext) nodeFull(nodeName string) bool {
+	return lpc.MaxPodsPerNode > 0 && len(nodeName) > 0 && lpc.nodePods[nodeName] >= lpc.MaxPodsPerNode
+}
+
+// remove removes a pod from the pods that use capacity. It must be called with the lock held.
+func (lpc *LimitedPodContext) remove(key types.NamespacedName) {
+	nodeName := lpc.pods[key]
+	delete(lpc.pods, key)
+	if len(nodeName) == 0 {
+		return
+	}
+	lpc.nodePods[nodeName]--
+	if lpc.nodePods[nodeName] <= 0 {
+		delete(lpc.nodePods, nodeName)
+	}
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+)
+
+var _ = Describe("LimitedPodContext", func() {
+	var (
+		ctx        = context.TODO()
+		podContext *recordingPodContext
+	)
+
+	BeforeEach(func() {
+		podContext = &recordingPodContext{}
+	})
+
+	newLimitedPodContext := func(maxPods, maxPodsPerNode int) *pod.LimitedPodContext {
+		limited, err := pod.NewLimitedPodContext(podContext, maxPods, maxPodsPerNode, slog.New(slog.DiscardHandler))
+		Expect(err).NotTo(HaveOccurred())
+		limited.WaitInterval = 10 * time.Millisecond
+		return limited
+	}
+
+	createAsync := func(limited *pod.LimitedPodContext, name, nodeName string) chan error {
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := limited.Create(ctx, pod.NewPrivilegedPod(name, "kube-system", "image", nodeName, nil))
+			errCh <- err
+		}()
+		return errCh
+	}
+
+	It("should wait for capacity in the cluster", func() {
+		limited := newLimitedPodContext(1, 0)
+
+		_, err := limited.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+		errCh := createAsync(limited, "foo-2", "node-2")
+		Consistently(errCh).ShouldNot(Receive())
+
+		Expect(limited.Delete(ctx, "foo-1", "kube-system")).To(Succeed())
+		Eventually(errCh).Should(Receive(BeNil()))
+	})
+
+	It("should wait for capacity on the node only", func() {
+		limited := newLimitedPodContext(0, 1)
+
+		_, err := limited.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+		errCh := createAsync(limited, "foo-2", "node-1")
+		Consistently(errCh).ShouldNot(Receive())
+
+		_, err = limited.Create(ctx, pod.NewPrivilegedPod("foo-3", "kube-system", "image", "node-2", nil))
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(errCh).ShouldNot(Receive())
+
+		Expect(limited.Delete(ctx, "foo-1", "kube-system")).To(Succeed())
+		Eventually(errCh).Should(Receive(BeNil()))
+	})
+
+	It("should serve waiting callers in order", func() {
+		limited := newLimitedPodContext(1, 0)
+
+		_, err := limited.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "", nil))
+		Expect(err).NotTo(HaveOccurred())
+		errCh2 := createAsync(limited, "foo-2", "")
+		Consistently(errCh2).ShouldNot(Receive())
+		errCh3 := createAsync(limited, "foo-3", "")
+		Consistently(errCh3).ShouldNot(Receive())
+
+		Expect(limited.Delete(ctx, "foo-1", "kube-system")).To(Succeed())
+		Eventually(errCh2).Should(Receive(BeNil()))
+		Consistently(errCh3).ShouldNot(Receive())
+
+		Expect(limited.Delete(ctx, "foo-2", "kube-system")).To(Succeed())
+		Eventually(errCh3).Should(Receive(BeNil()))
+	})
+
+	It("should stop waiting when the context is done", func() {
+		limited := newLimitedPodContext(1, 0)
+
+		_, err := limited.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "", nil))
+		Expect(err).NotTo(HaveOccurred())
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = limited.Create(timeoutCtx, pod.NewPrivilegedPod("foo-2", "kube-system", "image", "", nil))
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		Expect(limited.Delete(ctx, "foo-1", "kube-system")).To(Succeed())
+		_, err = limited.Create(ctx, pod.NewPrivilegedPod("foo-3", "kube-system", "image", "", nil))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should release the capacity when the pod could not be created", func() {
+		limited := newLimitedPodContext(1, 1)
+		podContext.createErr = errors.New("foo")
+
+		_, err := limited.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "node-1", nil))
+		Expect(err).To(MatchError("foo"))
+
+		podContext.createErr = nil
+		errCh := createAsync(limited, "foo-2", "node-1")
+		Eventually(errCh).Should(Receive(BeNil()))
+	})
+
+	It("should reclaim idle pooled pods", func() {
+		limited := newLimitedPodContext(1, 0)
+		pool, err := pod.NewPooledPodContext(limited)
+		Expect(err).NotTo(HaveOccurred())
+		limited.AddReclaimer(pool)
+
+		_, err = pool.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = pool.Create(ctx, pod.NewPrivilegedPod("foo-2", "kube-system", "image", "node-2", nil))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.deleted).To(Equal([]string{podContext.created[0].Name}))
+	})
+
+	It("should reclaim idle pooled pods of all pools sharing the capacity", func() {
+		limited := newLimitedPodContext(1, 0)
+		pool1, err := pod.NewPooledPodContext(limited)
+		Expect(err).NotTo(HaveOccurred())
+		limited.AddReclaimer(pool1)
+		pool2, err := pod.NewPooledPodContext(limited)
+		Expect(err).NotTo(HaveOccurred())
+		limited.AddReclaimer(pool2)
+
+		_, err = pool1.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = pool2.Create(ctx, pod.NewPrivilegedPod("foo-2", "kube-system", "image", "node-2", nil))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.deleted).To(Equal([]string{podContext.created[0].Name}))
+	})
+})
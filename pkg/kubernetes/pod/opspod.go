@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"log/slog"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OpsPodConfig customizes the privileged ops pods created by diki.
+// Fields that are not set keep the values of [NewPrivilegedPod].
+type OpsPodConfig struct {
+	// Namespace is the namespace of the ops pods. It must exist in every cluster where ops pods are created.
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Image is the full reference of the ops pod image, e.g. of a registry mirror. It replaces the diki-ops image.
+	Image string `json:"image" yaml:"image"`
+	// ImagePullSecrets are the secrets in the ops pod namespace that are used to pull the image.
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets" yaml:"imagePullSecrets"`
+	// Tolerations replace the default tolerations, which tolerate all NoSchedule and NoExecute taints.
+	Tolerations []corev1.Toleration `json:"tolerations" yaml:"tolerations"`
+	// PriorityClassName is the priority class of the ops pods.
+	PriorityClassName string `json:"priorityClassName" yaml:"priorityClassName"`
+	// Resources are the resource requirements of the ops pod container.
+	Resources *corev1.ResourceRequirements `json:"resources" yaml:"resources"`
+	// MaxPods is the max number of ops pods that exist at the same time in a cluster. Ops pods are not limited by default.
+	MaxPods *int `json:"maxPods" yaml:"maxPods"`
+	// MaxPodsPerNode is the max number of ops pods that exist at the same time on a node. Ops pods are not limited by default.
+	MaxPodsPerNode *int `json:"maxPodsPerNode" yaml:"maxPodsPerNode"`
+}
+
+// Validate validates the OpsPodConfig.
+func (c *OpsPodConfig) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if c == nil {
+		return allErrs
+	}
+
+	if len(c.Namespace) > 0 {
+		for _, msg := range validation.ValidateNamespaceName(c.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), c.Namespace, msg))
+		}
+	}
+	for i, secret := range c.ImagePullSecrets {
+		if len(secret.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("imagePullSecrets").Index(i).Child("name"), "must not be empty"))
+		}
+	}
+	if len(c.PriorityClassName) > 0 {
+		for _, msg := range validation.NameIsDNSSubdomain(c.PriorityClassName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("priorityClassName"), c.PriorityClassName, msg))
+		}
+	}
+	if c.MaxPods != nil && *c.MaxPods < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPods"), *c.MaxPods, "must not be negative"))
+	}
+	if c.MaxPodsPerNode != nil && *c.MaxPodsPerNode < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPodsPerNode"), *c.MaxPodsPerNode, "must not be negative"))
+	}
+	return allErrs
+}
+
+// PodNamespace returns the configured namespace of the ops pods or the given namespace if none is configured.
+func (c *OpsPodConfig) PodNamespace(namespace string) string {
+	if c == nil || len(c.Namespace) == 0 {
+		return namespace
+	}
+	return c.Namespace
+}
+
+// Apply sets the configured values on a pod.
+func (c *OpsPodConfig) Apply(pod *corev1.Pod) {
+	if c == nil {
+		return
+	}
+
+	pod.Namespace = c.PodNamespace(pod.Namespace)
+	for i := range pod.Spec.Containers {
+		if len(c.Image) > 0 {
+			pod.Spec.Containers[i].Image = c.Image
+		}
+		if c.Resources != nil {
+			pod.Spec.Containers[i].Resources = *c.Resources.DeepCopy()
+		}
+	}
+	pod.Spec.ImagePullSecrets = append(pod.Spec.ImagePullSecrets, c.ImagePullSecrets...)
+	if c.Tolerations != nil {
+		pod.Spec.Tolerations = slices.Clone(c.Tolerations)
+	}
+	if len(c.PriorityClassName) > 0 {
+		pod.Spec.PriorityClassName = c.PriorityClassName
+	}
+}
+
+// NewOpsPodContext creates the pod context of the ops pods in a cluster. It limits the number of ops pods
+// as configured and should be created once per cluster and shared by all rulesets that create ops pods in it.
+func NewOpsPodContext(c client.Client, config *rest.Config, additionalPodLabels map[string]string, opsPod *OpsPodConfig, logger *slog.Logger) (*LimitedPodContext, error) {
+	simplePodContext, err := NewSimplePodContext(c, config, additionalPodLabels)
+	if err != nil {
+		return nil, err
+	}
+	simplePodContext.OpsPodConfig = opsPod
+
+	var maxPods, maxPodsPerNode int
+	if opsPod != nil {
+		maxPods, maxPodsPerNode = ptr.Deref(opsPod.MaxPods, 0), ptr.Deref(opsPod.MaxPodsPerNode, 0)
+	}
+	return NewLimitedPodContext(simplePodContext, maxPods, maxPodsPerNode, logger)
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+)
+
+var _ = Describe("OpsPodConfig", func() {
+	Describe("#Apply", func() {
+		var privilegedPod *corev1.Pod
+
+		BeforeEach(func() {
+			privilegedPod = pod.NewPrivilegedPod("foo", "kube-system", "diki-ops:v1", "node", nil)()
+		})
+
+		It("should not change the pod when there is no config", func() {
+			expectedPod := privilegedPod.DeepCopy()
+
+			var config *pod.OpsPodConfig
+			config.Apply(privilegedPod)
+			Expect(privilegedPod).To(Equal(expectedPod))
+
+			(&pod.OpsPodConfig{}).Apply(privilegedPod)
+			Expect(privilegedPod).To(Equal(expectedPod))
+		})
+
+		It("should set the configured values", func() {
+			resources := corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10m")},
+			}
+			config := &pod.OpsPodConfig{
+				Namespace:         "diki",
+				Image:             "mirror/diki-ops:v1",
+				ImagePullSecrets:  []corev1.LocalObjectReference{{Name: "pull-secret"}},
+				Tolerations:       []corev1.Toleration{{Key: "foo", Operator: corev1.TolerationOpExists}},
+				PriorityClassName: "diki-ops",
+				Resources:         &resources,
+			}
+
+			config.Apply(privilegedPod)
+
+			Expect(privilegedPod.Namespace).To(Equal("diki"))
+			Expect(privilegedPod.Spec.Containers).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Image":     Equal("mirror/diki-ops:v1"),
+				"Resources": Equal(resources),
+			})))
+			Expect(privilegedPod.Spec.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{{Name: "pull-secret"}}))
+			Expect(privilegedPod.Spec.Tolerations).To(Equal([]corev1.Toleration{{Key: "foo", Operator: corev1.TolerationOpExists}}))
+			Expect(privilegedPod.Spec.PriorityClassName).To(Equal("diki-ops"))
+		})
+	})
+
+	Describe("#PodNamespace", func() {
+		It("should return the configured namespace", func() {
+			Expect((&pod.OpsPodConfig{Namespace: "diki"}).PodNamespace("kube-system")).To(Equal("diki"))
+		})
+
+		It("should return the given namespace when none is configured", func() {
+			var config *pod.OpsPodConfig
+			Expect(config.PodNamespace("kube-system")).To(Equal("kube-system"))
+			Expect((&pod.OpsPodConfig{}).PodNamespace("kube-system")).To(Equal("kube-system"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should allow a valid config", func() {
+			config := &pod.OpsPodConfig{
+				Namespace:         "diki",
+				ImagePullSecrets:  []corev1.LocalObjectReference{{Name: "pull-secret"}},
+				PriorityClassName: "diki-ops",
+				MaxPods:           ptr.To(10),
+				MaxPodsPerNode:    ptr.To(0),
+			}
+			Expect(config.Validate(field.NewPath("opsPod"))).To(BeEmpty())
+		})
+
+		It("should return errors for an invalid config", func() {
+			config := &pod.OpsPodConfig{
+				Namespace:         "Diki",
+				ImagePullSecrets:  []corev1.LocalObjectReference{{Name: ""}},
+				PriorityClassName: "diki_ops",
+				MaxPods:           ptr.To(-1),
+				MaxPodsPerNode:    ptr.To(-1),
+			}
+			Expect(config.Validate(field.NewPath("opsPod"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("opsPod.namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("opsPod.imagePullSecrets[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("opsPod.priorityClassName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("opsPod.maxPods"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("opsPod.maxPodsPerNode"),
+				})),
+			))
+		})
+	})
+})
@@ -19,6 +19,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/httpstream"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
@@ -29,6 +30,8 @@ import (
 // PodExecutor executes commands inside a pod.
 type PodExecutor interface {
 	Execute(ctx context.Context, command string, commandArg string) (string, error)
+	// Pod returns the name and namespace of the pod in which the commands are executed.
+	Pod() types.NamespacedName
 }
 
 // PodContext creates and deletes Pods.
@@ -55,6 +58,8 @@ type SimplePodContext struct {
 	config *rest.Config
 	// AdditionalPodLabels are labels to be added to the created pods. If the a label key is already set by the pod constructor function it is not overwritten.
 	AdditionalPodLabels map[string]string
+	// OpsPodConfig customizes the created pods. If it sets a namespace, pods are created and deleted in this namespace.
+	OpsPodConfig *OpsPodConfig
 	// WaitInterval is the time between wait API calls.
 	WaitInterval time.Duration
 	// WaitTimeout is the time waited for a pod to reach Running state or be deleted.
@@ -84,6 +89,7 @@ func (spc *SimplePodContext) Create(ctx context.Context, podConstructorFn func()
 			pod.Labels[label] = value
 		}
 	}
+	spc.OpsPodConfig.Apply(pod)
 
 	if err := spc.client.Create(ctx, pod); err != nil {
 		return nil, err
@@ -101,6 +107,7 @@ func (spc *SimplePodContext) Create(ctx context.Context, podConstructorFn func()
 
 // Delete deletes a specific pod and waits for it to be deleted.
 func (spc *SimplePodContext) Delete(ctx context.Context, name, namespace string) error {
+	namespace = spc.OpsPodConfig.PodNamespace(namespace)
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -130,6 +137,11 @@ func NewPodExecutor(client client.Client, config *rest.Config, name, namespace s
 	}, nil
 }
 
+// Pod returns the name and namespace of the pod.
+func (spe *SimplePodExecutor) Pod() types.NamespacedName {
+	return types.NamespacedName{Namespace: spe.namespace, Name: spe.name}
+}
+
 // Execute runs a command is a pod.
 func (spe *SimplePodExecutor) Execute(ctx context.Context, command string, commandArg string) (string, error) {
 	client, err := corev1client.NewForConfig(spe.config)
@@ -117,6 +117,32 @@ var _ = Describe("pod", func() {
 			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)
 			Expect(err).To(MatchError("pods \"foo\" not found"))
 		})
+
+		It("should create and delete diki pod in the configured namespace", func() {
+			spc, err := pod.NewSimplePodContext(fakeClient, fakeConfig, map[string]string{})
+			Expect(err).To(BeNil())
+			spc.OpsPodConfig = &pod.OpsPodConfig{Namespace: "diki", Image: "mirror/diki-ops:v1"}
+
+			_, err = spc.Create(ctx, fakePodContructor(name, namespace, ""))
+			Expect(err).To(BeNil())
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "diki",
+				},
+			}
+
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)
+			Expect(err).To(BeNil())
+			Expect(pod.Spec.Containers[0].Image).To(Equal("mirror/diki-ops:v1"))
+
+			err = spc.Delete(ctx, name, namespace)
+			Expect(err).To(BeNil())
+
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)
+			Expect(err).To(MatchError("pods \"foo\" not found"))
+		})
 	})
 })
 
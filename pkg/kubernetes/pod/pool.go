@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// PooledPodContext shares one privileged pod per node and image between all callers of Create.
+// Pods are created lazily on first use and are kept until Close is called, callers do not delete them.
+// The executors returned by Create report the pooled pod in which commands are executed. A pod is only
+// in use while a command is executed in it, idle pods can be deleted with Reclaim and are created again
+// when needed. Pods that are not privileged diki pods or not scheduled to a specific node are passed
+// through to the underlying PodContext.
+type PooledPodContext struct {
+	podContext PodContext
+	// MaxPodAge is the max age of a pooled pod when a command is executed in it. Older pods are replaced,
+	// because privileged pods terminate after a few minutes.
+	MaxPodAge time.Duration
+
+	mu   sync.Mutex
+	pods map[poolKey]*pooledPod
+	// retired are replaced pods that are deleted when they are no longer in use.
+	retired []*pooledPod
+}
+
+var (
+	_ PodContext = &PooledPodContext{}
+	_ Reclaimer  = &PooledPodContext{}
+)
+
+type poolKey struct {
+	namespace, nodeName, image string
+}
+
+// pooledPod is a pod shared by all callers that requested a pod for the same node and image.
+type pooledPod struct {
+	key             poolKey
+	name, namespace string
+	// ready is closed when the pod is created or its creation failed.
+	ready    chan struct{}
+	created  time.Time
+	lastUsed time.Time
+	executor PodExecutor
+	err      error
+	// inUse is the number of callers that currently use the pod.
+	inUse int
+}
+
+// pooledPodExecutor executes commands in the pooled pod of a node.
+type pooledPodExecutor struct {
+	pool     *PooledPodContext
+	key      poolKey
+	template *corev1.Pod
+	// pod is the pooled pod in which the last command was executed.
+	pod types.NamespacedName
+}
+
+// NewPooledPodContext creates a new PooledPodContext that creates and deletes pods with the given PodContext.
+func NewPooledPodContext(podContext PodContext) (*PooledPodContext, error) {
+	return &PooledPodContext{
+		podContext: podContext,
+		MaxPodAge:  3 * time.Minute,
+		pods:       map[poolKey]*pooledPod{},
+	}, nil
+}
+
+// Create returns an executor for the pooled pod of the node and image of the constructed pod. The pod is
+// created if there is none yet. Concurrent callers wait for the creation of the same pod.
+func (ppc *PooledPodContext) Create(ctx context.Context, podConstructorFn func() *corev1.Pod) (PodExecutor, error) {
+	pod := podConstructorFn()
+	nodeName := pod.Spec.NodeSelector[corev1.LabelHostname]
+	if pod.Labels[LabelComplianceRoleKey] != LabelComplianceRolePrivPod || len(nodeName) == 0 || len(pod.Spec.Containers) != 1 {
+		return ppc.podContext.Create(ctx, func() *corev1.Pod { return pod })
+	}
+
+	executor := &pooledPodExecutor{
+		pool:     ppc,
+		key:      poolKey{namespace: pod.Namespace, nodeName: nodeName, image: pod.Spec.Containers[0].Image},
+		template: pod,
+	}
+	p, err := ppc.acquire(ctx, executor.key, executor.template)
+	if err != nil {
+		return nil, err
+	}
+	executor.pod = p.executor.Pod()
+	ppc.release(ctx, p)
+	return executor, nil
+}
+
+// Delete deletes a pod that is not pooled. Pooled pods are kept until they are reclaimed or the context is closed.
+func (ppc *PooledPodContext) Delete(ctx context.Context, name, namespace string) error {
+	ppc.mu.Lock()
+	pooled := slices.ContainsFunc(ppc.retired, func(p *pooledPod) bool { return p.name == name })
+	for _, p := range ppc.pods {
+		pooled = pooled || p.name == name
+	}
+	ppc.mu.Unlock()
+
+	if pooled {
+		return nil
+	}
+	return ppc.podContext.Delete(ctx, name, namespace)
+}
+
+// Reclaim deletes the pooled pod that was not used for the longest time. If a node name is given only pods
+// on this node are considered. It returns false if there is no pod that is not in use.
+func (ppc *PooledPodContext) Reclaim(ctx context.Context, nodeName string) bool {
+	ppc.mu.Lock()
+	var idle *pooledPod
+	for _, p := range ppc.pods {
+		if p.inUse > 0 || !p.isReady() || (len(nodeName) > 0 && p.key.nodeName != nodeName) {
+			continue
+		}
+		if idle == nil || p.lastUsed.Before(idle.lastUsed) {
+			idle = p
+		}
+	}
+	if idle != nil {
+		delete(ppc.pods, idle.key)
+	}
+	ppc.mu.Unlock()
+
+	if idle == nil {
+		return false
+	}
+	ppc.delete(ctx, idle)
+	return true
+}
+
+// Close deletes all pooled pods, including pods that are still in use. Pods that are being created
+// are deleted after their creation finished. The PooledPodContext can be used again afterwards.
+func (ppc *PooledPodContext) Close(ctx context.Context) error {
+	ppc.mu.Lock()
+	pods := ppc.retired
+	for _, p := range ppc.pods {
+		pods = append(pods, p)
+	}
+	ppc.pods = map[poolKey]*pooledPod{}
+	ppc.retired = nil
+	ppc.mu.Unlock()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, p := range pods {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case <-p.ready:
+			case <-ctx.Done():
+			}
+			if err := ppc.podContext.Delete(ctx, p.name, p.namespace); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("failed to delete pod %s/%s: %w", p.namespace, p.name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// acquire returns the ready pooled pod for the given key and marks it as in use. The pod is created from the
+// template if there is none or the existing one is too old or failed to get ready. It must be released after use.
+func (ppc *PooledPodContext) acquire(ctx context.Context, key poolKey, template *corev1.Pod) (*pooledPod, error) {
+	var (
+		creator  bool
+		replaced *pooledPod
+	)
+
+	ppc.mu.Lock()
+	p, ok := ppc.pods[key]
+	if ok && p.isReady() && (p.err != nil || time.Since(p.created) > ppc.MaxPodAge) {
+		delete(ppc.pods, key)
+		if replaced, ok = p, false; replaced.inUse > 0 {
+			ppc.retired = append(ppc.retired, replaced)
+			replaced = nil
+		}
+	}
+	if !ok {
+		p = &pooledPod{
+			key:       key,
+			name:      fmt.Sprintf("diki-pool-%s", rand.String(10)),
+			namespace: key.namespace,
+			ready:     make(chan struct{}),
+		}
+		ppc.pods[key] = p
+		creator = true
+	}
+	p.inUse++
+	ppc.mu.Unlock()
+
+	if replaced != nil {
+		ppc.delete(ctx, replaced)
+	}
+
+	if creator {
+		pod := template.DeepCopy()
+		pod.Name = p.name
+		p.executor, p.err = ppc.podContext.Create(ctx, func() *corev1.Pod { return pod })
+		p.created = time.Now()
+		close(p.ready)
+	}
+
+	select {
+	case <-p.ready:
+	case <-ctx.Done():
+		ppc.release(ctx, p)
+		return nil, ctx.Err()
+	}
+	if p.err != nil {
+		ppc.release(ctx, p)
+		return nil, p.err
+	}
+	return p, nil
+}
+
+// release marks a pod as no longer used by a caller. Replaced pods are deleted when they are no longer in use.
+func (ppc *PooledPodContext) release(ctx context.Context, p *pooledPod) {
+	ppc.mu.Lock()
+	p.inUse--
+	p.lastUsed = time.Now()
+	i := slices.Index(ppc.retired, p)
+	if i < 0 || p.inUse > 0 {
+		ppc.mu.Unlock()
+		return
+	}
+	ppc.retired = slices.Delete(ppc.retired, i, i+1)
+	ppc.mu.Unlock()
+
+	ppc.delete(ctx, p)
+}
+
+// delete deletes a pod that is no longer pooled. Pods that cannot be deleted are deleted again when the context is closed.
+func (ppc *PooledPodContext) delete(ctx context.Context, p *pooledPod) {
+	if err := ppc.podContext.Delete(context.WithoutCancel(ctx), p.name, p.namespace); err != nil {
+		ppc.mu.Lock()
+		ppc.retired = append(ppc.retired, p)
+		ppc.mu.Unlock()
+	}
+}
+
+func (p *pooledPod) isReady() bool {
+	select {
+	case <-p.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// Execute runs a command in the pooled pod, which is created again if it was replaced or reclaimed.
+func (ppe *pooledPodExecutor) Execute(ctx context.Context, command string, commandArg string) (string, error) {
+	p, err := ppe.pool.acquire(ctx, ppe.key, ppe.template)
+	if err != nil {
+		return "", err
+	}
+	defer ppe.pool.release(ctx, p)
+
+	ppe.pool.mu.Lock()
+	ppe.pod = p.executor.Pod()
+	ppe.pool.mu.Unlock()
+
+	return p.executor.Execute(ctx, command, commandArg)
+}
+
+// Pod returns the pooled pod in which the last command was executed.
+func (ppe *pooledPodExecutor) Pod() types.NamespacedName {
+	ppe.pool.mu.Lock()
+	defer ppe.pool.mu.Unlock()
+
+	return ppe.pod
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+)
+
+var _ = Describe("PooledPodContext", func() {
+	var (
+		ctx        = context.TODO()
+		podContext *recordingPodContext
+		pool       *pod.PooledPodContext
+	)
+
+	BeforeEach(func() {
+		podContext = &recordingPodContext{}
+
+		var err error
+		pool, err = pod.NewPooledPodContext(podContext)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should share one pod per node", func() {
+		executor1, err := pool.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+		executor2, err := pool.Create(ctx, pod.NewPrivilegedPod("foo-2", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = pool.Create(ctx, pod.NewPrivilegedPod("foo-3", "kube-system", "image", "node-2", nil))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(executor1.Execute(ctx, "/bin/sh", "foo")).To(Equal("foo"))
+		Expect(executor2.Execute(ctx, "/bin/sh", "bar")).To(Equal("bar"))
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.created[0].Spec.NodeSelector).To(HaveKeyWithValue("kubernetes.io/hostname", "node-1"))
+		Expect(podContext.created[0].Name).To(HavePrefix("diki-pool-"))
+		Expect(podContext.created[1].Spec.NodeSelector).To(HaveKeyWithValue("kubernetes.io/hostname", "node-2"))
+		Expect(executor1.Pod()).To(Equal(types.NamespacedName{Namespace: "kube-system", Name: podContext.created[0].Name}))
+		Expect(executor2.Pod()).To(Equal(executor1.Pod()))
+
+		Expect(pool.Delete(ctx, podContext.created[0].Name, "kube-system")).To(Succeed())
+		Expect(podContext.deleted).To(BeEmpty())
+
+		Expect(pool.Close(ctx)).To(Succeed())
+		Expect(podContext.deleted).To(ConsistOf(podContext.created[0].Name, podContext.created[1].Name))
+	})
+
+	It("should create the pod of a node once for concurrent callers", func() {
+		var wg sync.WaitGroup
+		for i := range 10 {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				_, err := pool.Create(ctx, pod.NewPrivilegedPod(fmt.Sprintf("foo-%d", i), "kube-system", "image", "node-1", nil))
+				Expect(err).NotTo(HaveOccurred())
+			}()
+		}
+		wg.Wait()
+
+		Expect(podContext.created).To(HaveLen(1))
+	})
+
+	It("should pass pods that are not scheduled to a specific node through", func() {
+		_, err := pool.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "", nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(podContext.created).To(HaveLen(1))
+		Expect(podContext.created[0].Name).To(Equal("foo"))
+
+		Expect(pool.Delete(ctx, "foo", "kube-system")).To(Succeed())
+		Expect(podContext.deleted).To(Equal([]string{"foo"}))
+	})
+
+	It("should replace a pod that failed to get ready", func() {
+		podContext.createErr = errors.New("foo")
+		_, err := pool.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "node-1", nil))
+		Expect(err).To(MatchError("foo"))
+		Expect(podContext.deleted).To(BeEmpty())
+
+		podContext.createErr = nil
+		_, err = pool.Create(ctx, pod.NewPrivilegedPod("foo-2", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.deleted).To(Equal([]string{podContext.created[0].Name}))
+	})
+
+	It("should replace pods that are too old", func() {
+		executor, err := pool.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+
+		pool.MaxPodAge = 0
+		Expect(executor.Execute(ctx, "/bin/sh", "foo")).To(Equal("foo"))
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(executor.Pod().Name).To(Equal(podContext.created[1].Name))
+		Expect(podContext.deleted).To(Equal([]string{podContext.created[0].Name}))
+
+		Expect(pool.Close(ctx)).To(Succeed())
+		Expect(podContext.deleted).To(Equal([]string{podContext.created[0].Name, podContext.created[1].Name}))
+	})
+
+	It("should reclaim the pod that was not used for the longest time", func() {
+		executor1, err := pool.Create(ctx, pod.NewPrivilegedPod("foo-1", "kube-system", "image", "node-1", nil))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = pool.Create(ctx, pod.NewPrivilegedPod("foo-2", "kube-system", "image", "node-2", nil))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(pool.Reclaim(ctx, "node-2")).To(BeTrue())
+		Expect(podContext.deleted).To(Equal([]string{podContext.created[1].Name}))
+		Expect(pool.Reclaim(ctx, "node-2")).To(BeFalse())
+		Expect(pool.Reclaim(ctx, "")).To(BeTrue())
+		Expect(podContext.deleted).To(Equal([]string{podContext.created[1].Name, podContext.created[0].Name}))
+
+		Expect(executor1.Execute(ctx, "/bin/sh", "foo")).To(Equal("foo"))
+		Expect(podContext.created).To(HaveLen(3))
+		Expect(podContext.created[2].Spec.NodeSelector).To(HaveKeyWithValue("kubernetes.io/hostname", "node-1"))
+	})
+})
+
+// recordingPodContext records the created and deleted pods.
+type recordingPodContext struct {
+	mu        sync.Mutex
+	created   []*corev1.Pod
+	deleted   []string
+	createErr error
+}
+
+func (r *recordingPodContext) Create(_ context.Context, podConstructorFn func() *corev1.Pod) (pod.PodExecutor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := podConstructorFn()
+	r.created = append(r.created, p)
+	if r.createErr != nil {
+		return nil, r.createErr
+	}
+	return echoPodExecutor{pod: types.NamespacedName{Namespace: p.Namespace, Name: p.Name}}, nil
+}
+
+func (r *recordingPodContext) Delete(_ context.Context, name, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deleted = append(r.deleted, name)
+	return nil
+}
+
+// echoPodExecutor returns the command argument as output.
+type echoPodExecutor struct {
+	pod types.NamespacedName
+}
+
+func (echoPodExecutor) Execute(_ context.Context, _ string, commandArg string) (string, error) {
+	return commandArg, nil
+}
+
+func (e echoPodExecutor) Pod() types.NamespacedName {
+	return e.pod
+}
@@ -5,11 +5,18 @@
 package pod
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"maps"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -98,3 +105,39 @@ func NewPrivilegedPod(name, namespace, image, nodeName string, additionalLabels
 		return pod
 	}
 }
+
+// DeletePrivilegedPods deletes the privileged pods created by diki in all namespaces of a cluster.
+// If instance IDs are given only the pods of these instances are deleted. It returns the deleted pods
+// and does not wait for them to be gone.
+func DeletePrivilegedPods(ctx context.Context, c client.Client, instanceIDs ...string) ([]client.ObjectKey, error) {
+	selector := labels.SelectorFromSet(labels.Set{LabelComplianceRoleKey: LabelComplianceRolePrivPod})
+	if len(instanceIDs) > 0 {
+		instanceRequirement, err := labels.NewRequirement(LabelInstanceID, selection.In, instanceIDs)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*instanceRequirement)
+	}
+
+	podList := &metav1.PartialObjectMetadataList{}
+	podList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("PodList"))
+	if err := c.List(ctx, podList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	var (
+		deleted []client.ObjectKey
+		errs    []error
+	)
+	for _, item := range podList.Items {
+		p := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: item.Name, Namespace: item.Namespace}}
+		if err := c.Delete(ctx, p); err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to delete pod %s: %w", client.ObjectKeyFromObject(p), err))
+			}
+			continue
+		}
+		deleted = append(deleted, client.ObjectKeyFromObject(p))
+	}
+	return deleted, errors.Join(errs...)
+}
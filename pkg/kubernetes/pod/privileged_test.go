@@ -5,6 +5,7 @@
 package pod_test
 
 import (
+	"context"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -12,6 +13,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/diki/pkg/kubernetes/pod"
 )
@@ -104,4 +107,50 @@ var _ = Describe("podutils", func() {
 			Expect(podFunc()).To(Equal(expectedPod))
 		})
 	})
+	Describe("#DeletePrivilegedPods", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+		)
+
+		newPod := func(name, namespace string, labels map[string]string) *corev1.Pod {
+			return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+		}
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithObjects(
+				newPod("diki-1", "kube-system", map[string]string{pod.LabelComplianceRoleKey: pod.LabelComplianceRolePrivPod, pod.LabelInstanceID: "1"}),
+				newPod("diki-2", "foo", map[string]string{pod.LabelComplianceRoleKey: pod.LabelComplianceRolePrivPod, pod.LabelInstanceID: "2"}),
+				newPod("diki-3", "kube-system", map[string]string{pod.LabelComplianceRoleKey: pod.LabelComplianceRolePrivPod}),
+				newPod("other", "kube-system", map[string]string{pod.LabelInstanceID: "1"}),
+			).Build()
+		})
+
+		It("should delete all privileged pods", func() {
+			deleted, err := pod.DeletePrivilegedPods(ctx, fakeClient)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(ConsistOf(
+				client.ObjectKey{Name: "diki-1", Namespace: "kube-system"},
+				client.ObjectKey{Name: "diki-2", Namespace: "foo"},
+				client.ObjectKey{Name: "diki-3", Namespace: "kube-system"},
+			))
+
+			podList := &corev1.PodList{}
+			Expect(fakeClient.List(ctx, podList)).To(Succeed())
+			Expect(podList.Items).To(HaveLen(1))
+			Expect(podList.Items[0].Name).To(Equal("other"))
+		})
+
+		It("should delete only the privileged pods of the given instances", func() {
+			deleted, err := pod.DeletePrivilegedPods(ctx, fakeClient, "2", "3")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(ConsistOf(client.ObjectKey{Name: "diki-2", Namespace: "foo"}))
+
+			podList := &corev1.PodList{}
+			Expect(fakeClient.List(ctx, podList)).To(Succeed())
+			Expect(podList.Items).To(HaveLen(3))
+		})
+	})
 })
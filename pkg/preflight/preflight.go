@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/imagevector"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/shared/images"
+)
+
+// Status is the status of a preflight check.
+type Status string
+
+const (
+	// Passed indicates that the environment satisfies the check.
+	Passed Status = "Passed"
+	// Warning indicates that the check could not be fully performed, but does not block a run.
+	Warning Status = "Warning"
+	// Failed indicates a blocker that causes rules to error during a run.
+	Failed Status = "Failed"
+)
+
+const (
+	// CheckReachability is the name of the check that verifies that the API server is reachable.
+	CheckReachability = "API reachability"
+	// CheckPermission is the name of the checks that review a permission of the current user.
+	CheckPermission = "Permission"
+	// CheckOpsPod is the name of the check that creates and deletes a privileged ops pod.
+	CheckOpsPod = "Ops pod"
+
+	// opsPodTimeout is the max time waited for an ops pod to be running.
+	opsPodTimeout = 2 * time.Minute
+)
+
+// Requirement describes what a ruleset needs from a cluster to run its rules.
+type Requirement struct {
+	// Cluster is the name of the cluster, e.g. shoot or seed.
+	// Rulesets that can be used with different providers leave it empty for the provider's cluster.
+	Cluster string
+	// Config is used to access the cluster.
+	Config *rest.Config
+	// Permissions are the resources and verbs that are accessed by the rules.
+	Permissions []authorizationv1.ResourceAttributes
+	// OpsPod is set if the rules create privileged ops pods in the cluster.
+	OpsPod *OpsPodRequirement
+}
+
+// OpsPodRequirement describes the privileged ops pods created by the rules.
+type OpsPodRequirement struct {
+	// Namespace is the namespace of the ops pods.
+	Namespace string
+	// AdditionalLabels are the additional labels of the ops pods.
+	AdditionalLabels map[string]string
+	// Config customizes the ops pods.
+	Config *pod.OpsPodConfig
+	// InstanceIDs are the values of the instance ID label of the ops pods.
+	InstanceIDs []string
+}
+
+// Ruleset is implemented by rulesets that can describe their requirements.
+type Ruleset interface {
+	PreflightRequirements() []Requirement
+}
+
+// Result is the result of a preflight check.
+type Result struct {
+	Cluster string
+	Check   string
+	Status  Status
+	Message string
+}
+
+// Checker checks that a cluster satisfies a requirement.
+type Checker struct {
+	Clientset  kubernetes.Interface
+	PodContext pod.PodContext
+}
+
+// NewChecker creates a [Checker] for the cluster of a requirement.
+func NewChecker(requirement Requirement) (*Checker, error) {
+	clientset, err := kubernetes.NewForConfig(requirement.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.New(requirement.Config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		additionalLabels map[string]string
+		opsPodConfig     *pod.OpsPodConfig
+	)
+	if requirement.OpsPod != nil {
+		additionalLabels = requirement.OpsPod.AdditionalLabels
+		opsPodConfig = requirement.OpsPod.Config
+	}
+	podContext, err := pod.NewSimplePodContext(c, requirement.Config, additionalLabels)
+	if err != nil {
+		return nil, err
+	}
+	podContext.OpsPodConfig = opsPodConfig
+	podContext.WaitTimeout = opsPodTimeout
+
+	return &Checker{
+		Clientset:  clientset,
+		PodContext: podContext,
+	}, nil
+}
+
+// Check runs the preflight checks for the given requirements. Requirements for the same
+// cluster are merged, so that every cluster is checked once.
+func Check(ctx context.Context, requirements []Requirement) []Result {
+	var results []Result
+	for _, requirement := range MergeRequirements(requirements) {
+		checker, err := NewChecker(requirement)
+		if err != nil {
+			results = append(results, Result{Cluster: requirement.Cluster, Check: CheckReachability, Status: Failed, Message: err.Error()})
+			continue
+		}
+		results = append(results, checker.Check(ctx, requirement)...)
+	}
+	return results
+}
+
+// MergeRequirements merges the requirements for the same cluster. The order of the clusters is kept.
+func MergeRequirements(requirements []Requirement) []Requirement {
+	var (
+		merged  []Requirement
+		indexes = map[string]int{}
+	)
+	for _, requirement := range requirements {
+		i, ok := indexes[requirement.Cluster]
+		if !ok {
+			indexes[requirement.Cluster] = len(merged)
+			merged = append(merged, Requirement{Cluster: requirement.Cluster, Config: requirement.Config})
+			i = len(merged) - 1
+		}
+
+		for _, permission := range requirement.Permissions {
+			if !slices.Contains(merged[i].Permissions, permission) {
+				merged[i].Permissions = append(merged[i].Permissions, permission)
+			}
+		}
+		switch {
+		case requirement.OpsPod == nil:
+		case merged[i].OpsPod == nil:
+			opsPod := *requirement.OpsPod
+			opsPod.InstanceIDs = slices.Clone(opsPod.InstanceIDs)
+			merged[i].OpsPod = &opsPod
+		default:
+			for _, instanceID := range requirement.OpsPod.InstanceIDs {
+				if !slices.Contains(merged[i].OpsPod.InstanceIDs, instanceID) {
+					merged[i].OpsPod.InstanceIDs = append(merged[i].OpsPod.InstanceIDs, instanceID)
+				}
+			}
+		}
+	}
+	return merged
+}
+
+// Check runs the preflight checks for a single requirement. Permission and ops pod
+// checks are only performed if the API server of the cluster is reachable.
+func (c *Checker) Check(ctx context.Context, requirement Requirement) []Result {
+	newResult := func(check string, status Status, message string) Result {
+		return Result{Cluster: requirement.Cluster, Check: check, Status: status, Message: message}
+	}
+
+	serverVersion, err := c.Clientset.Discovery().ServerVersion()
+	if err != nil {
+		return []Result{newResult(CheckReachability, Failed, err.Error())}
+	}
+	results := []Result{newResult(CheckReachability, Passed, "Kubernetes "+serverVersion.GitVersion)}
+
+	permissions := slices.Clone(requirement.Permissions)
+	if requirement.OpsPod != nil {
+		for _, permission := range opsPodPermissions(requirement.OpsPod.Namespace) {
+			if !slices.Contains(permissions, permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+
+	for _, permission := range permissions {
+		check := CheckPermission + " " + permissionString(permission)
+		review, err := c.Clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &permission},
+		}, metav1.CreateOptions{})
+		switch {
+		case err != nil:
+			results = append(results, newResult(check, Failed, err.Error()))
+		case review.Status.Allowed:
+			results = append(results, newResult(check, Passed, "Access is allowed."))
+		default:
+			results = append(results, newResult(check, Failed, cmp.Or(review.Status.Reason, "Access is denied.")))
+		}
+	}
+
+	if requirement.OpsPod != nil {
+		results = append(results, c.checkOpsPod(ctx, requirement.Cluster, *requirement.OpsPod))
+	}
+	return results
+}
+
+func (c *Checker) checkOpsPod(ctx context.Context, cluster string, opsPod OpsPodRequirement) (result Result) {
+	result = Result{Cluster: cluster, Check: CheckOpsPod}
+
+	nodeList, err := c.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		result.Status, result.Message = Failed, err.Error()
+		return result
+	}
+
+	nodes := slices.DeleteFunc(nodeList.Items, func(node corev1.Node) bool {
+		return node.Spec.Unschedulable || !kubeutils.NodeReadyStatus(node)
+	})
+	if len(nodes) == 0 {
+		result.Status, result.Message = Warning, "There are no ready nodes to create an ops pod on."
+		return result
+	}
+	node := slices.MinFunc(nodes, func(n1, n2 corev1.Node) int {
+		return cmp.Compare(n1.Name, n2.Name)
+	})
+
+	image, err := imagevector.ImageVector().FindImage(images.DikiOpsImageName)
+	if err != nil {
+		result.Status, result.Message = Failed, fmt.Sprintf("failed to find image version for %s: %s", images.DikiOpsImageName, err)
+		return result
+	}
+	image.WithOptionalTag(version.Get().GitVersion)
+
+	podName := "diki-preflight-" + rand.String(10)
+	defer func() {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := c.PodContext.Delete(timeoutCtx, podName, opsPod.Namespace); err != nil {
+			deleteMessage := fmt.Sprintf("failed to delete ops pod %s/%s: %s", opsPod.Namespace, podName, err)
+			if result.Status == Failed {
+				deleteMessage = result.Message + ", " + deleteMessage
+			}
+			result.Status, result.Message = Failed, deleteMessage
+		}
+	}()
+
+	podExecutor, err := c.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, opsPod.Namespace, image.String(), node.Name, maps.Clone(opsPod.AdditionalLabels)))
+	if err != nil {
+		result.Status, result.Message = Failed, fmt.Sprintf("failed to create ops pod on node %s: %s", node.Name, err)
+		return result
+	}
+
+	if _, err := podExecutor.Execute(ctx, "/bin/sh", "true"); err != nil {
+		result.Status, result.Message = Failed, fmt.Sprintf("failed to execute command in ops pod on node %s: %s", node.Name, err)
+		return result
+	}
+
+	result.Status, result.Message = Passed, fmt.Sprintf("Ops pod was created and deleted on node %s.", node.Name)
+	return result
+}
+
+// opsPodPermissions returns the permissions needed to create, use and delete ops pods in a namespace.
+func opsPodPermissions(namespace string) []authorizationv1.ResourceAttributes {
+	return []authorizationv1.ResourceAttributes{
+		{Namespace: namespace, Verb: "create", Resource: "pods"},
+		{Namespace: namespace, Verb: "get", Resource: "pods"},
+		{Namespace: namespace, Verb: "delete", Resource: "pods"},
+		{Namespace: namespace, Verb: "create", Resource: "pods", Subresource: "exec"},
+		{Verb: "list", Resource: "nodes"},
+	}
+}
+
+func permissionString(permission authorizationv1.ResourceAttributes) string {
+	resource := permission.Resource
+	if len(permission.Group) > 0 {
+		resource += "." + permission.Group
+	}
+	if len(permission.Subresource) > 0 {
+		resource += "/" + permission.Subresource
+	}
+
+	parts := []string{permission.Verb, resource}
+	if len(permission.Namespace) > 0 {
+		parts = append(parts, "in namespace "+permission.Namespace)
+	}
+	if len(permission.Name) > 0 {
+		parts = append(parts, "named "+permission.Name)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	"github.com/gardener/diki/pkg/preflight"
+)
+
+var _ = Describe("preflight", func() {
+	var (
+		ctx = context.TODO()
+
+		listPods   = authorizationv1.ResourceAttributes{Verb: "list", Resource: "pods"}
+		getSecrets = authorizationv1.ResourceAttributes{Namespace: "foo", Verb: "get", Resource: "secrets"}
+		listShoots = authorizationv1.ResourceAttributes{Verb: "list", Group: "core.gardener.cloud", Resource: "shoots"}
+		readyNode  = func(name string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}},
+			}
+		}
+	)
+
+	Describe("#MergeRequirements", func() {
+		It("should merge the requirements of the same cluster", func() {
+			opsPod := &preflight.OpsPodRequirement{Namespace: "kube-system"}
+			merged := preflight.MergeRequirements([]preflight.Requirement{
+				{Cluster: "shoot", Permissions: []authorizationv1.ResourceAttributes{listPods}},
+				{Cluster: "seed", Permissions: []authorizationv1.ResourceAttributes{getSecrets}},
+				{Cluster: "shoot", Permissions: []authorizationv1.ResourceAttributes{listPods, listShoots}, OpsPod: opsPod},
+			})
+
+			Expect(merged).To(Equal([]preflight.Requirement{
+				{Cluster: "shoot", Permissions: []authorizationv1.ResourceAttributes{listPods, listShoots}, OpsPod: opsPod},
+				{Cluster: "seed", Permissions: []authorizationv1.ResourceAttributes{getSecrets}},
+			}))
+		})
+
+		It("should merge the instance ids of the ops pods", func() {
+			opsPod1 := &preflight.OpsPodRequirement{Namespace: "kube-system", InstanceIDs: []string{"1"}}
+			opsPod2 := &preflight.OpsPodRequirement{Namespace: "kube-system", InstanceIDs: []string{"2", "1"}}
+			merged := preflight.MergeRequirements([]preflight.Requirement{
+				{Cluster: "shoot", OpsPod: opsPod1},
+				{Cluster: "shoot", OpsPod: opsPod2},
+			})
+
+			Expect(merged).To(Equal([]preflight.Requirement{
+				{Cluster: "shoot", OpsPod: &preflight.OpsPodRequirement{Namespace: "kube-system", InstanceIDs: []string{"1", "2"}}},
+			}))
+			Expect(opsPod1.InstanceIDs).To(Equal([]string{"1"}))
+		})
+	})
+
+	Describe("#Checker", func() {
+		var (
+			clientset *fake.Clientset
+			checker   *preflight.Checker
+		)
+
+		BeforeEach(func() {
+			clientset = fake.NewClientset(readyNode("node-2"), readyNode("node-1"), &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0"}})
+			clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.33.0"}
+			clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				switch review.Spec.ResourceAttributes.Resource {
+				case "secrets":
+					review.Status.Reason = "secrets are forbidden"
+				case "shoots":
+					return true, nil, errors.New("shoots are not served")
+				default:
+					review.Status.Allowed = true
+				}
+				return true, review, nil
+			})
+
+			checker = &preflight.Checker{
+				Clientset:  clientset,
+				PodContext: fakepod.NewFakeSimplePodContext([][]string{{""}}, [][]error{{nil}}),
+			}
+		})
+
+		It("should check reachability, permissions and ops pods", func() {
+			results := checker.Check(ctx, preflight.Requirement{
+				Cluster:     "shoot",
+				Permissions: []authorizationv1.ResourceAttributes{listPods, getSecrets, listShoots},
+				OpsPod:      &preflight.OpsPodRequirement{Namespace: "kube-system"},
+			})
+
+			Expect(results).To(Equal([]preflight.Result{
+				{Cluster: "shoot", Check: "API reachability", Status: preflight.Passed, Message: "Kubernetes v1.33.0"},
+				{Cluster: "shoot", Check: "Permission list pods", Status: preflight.Passed, Message: "Access is allowed."},
+				{Cluster: "shoot", Check: "Permission get secrets in namespace foo", Status: preflight.Failed, Message: "secrets are forbidden"},
+				{Cluster: "shoot", Check: "Permission list shoots.core.gardener.cloud", Status: preflight.Failed, Message: "shoots are not served"},
+				{Cluster: "shoot", Check: "Permission create pods in namespace kube-system", Status: preflight.Passed, Message: "Access is allowed."},
+				{Cluster: "shoot", Check: "Permission get pods in namespace kube-system", Status: preflight.Passed, Message: "Access is allowed."},
+				{Cluster: "shoot", Check: "Permission delete pods in namespace kube-system", Status: preflight.Passed, Message: "Access is allowed."},
+				{Cluster: "shoot", Check: "Permission create pods/exec in namespace kube-system", Status: preflight.Passed, Message: "Access is allowed."},
+				{Cluster: "shoot", Check: "Permission list nodes", Status: preflight.Passed, Message: "Access is allowed."},
+				{Cluster: "shoot", Check: "Ops pod", Status: preflight.Passed, Message: "Ops pod was created and deleted on node node-1."},
+			}))
+		})
+
+		It("should fail when the ops pod cannot be created", func() {
+			checker.PodContext = fakepod.NewFakeSimplePodContext(nil, nil)
+
+			results := checker.Check(ctx, preflight.Requirement{Cluster: "shoot", OpsPod: &preflight.OpsPodRequirement{Namespace: "kube-system"}})
+			Expect(results[len(results)-1]).To(Equal(preflight.Result{
+				Cluster: "shoot",
+				Check:   "Ops pod",
+				Status:  preflight.Failed,
+				Message: "failed to create ops pod on node node-1: not enough return strings have been faked",
+			}))
+		})
+
+		It("should warn when there are no ready nodes", func() {
+			clientset = fake.NewClientset()
+			checker.Clientset = clientset
+
+			results := checker.Check(ctx, preflight.Requirement{Cluster: "shoot", OpsPod: &preflight.OpsPodRequirement{Namespace: "kube-system"}})
+			Expect(results[len(results)-1]).To(Equal(preflight.Result{
+				Cluster: "shoot",
+				Check:   "Ops pod",
+				Status:  preflight.Warning,
+				Message: "There are no ready nodes to create an ops pod on.",
+			}))
+		})
+
+		It("should only check reachability when the API server is not reachable", func() {
+			clientset.PrependReactor("get", "version", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("connection refused")
+			})
+
+			results := checker.Check(ctx, preflight.Requirement{
+				Cluster:     "shoot",
+				Permissions: []authorizationv1.ResourceAttributes{listPods},
+				OpsPod:      &preflight.OpsPodRequirement{Namespace: "kube-system"},
+			})
+			Expect(results).To(Equal([]preflight.Result{
+				{Cluster: "shoot", Check: "API reachability", Status: preflight.Failed, Message: "connection refused"},
+			}))
+		})
+	})
+})
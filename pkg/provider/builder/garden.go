@@ -14,6 +14,7 @@ import (
 	"github.com/gardener/diki/pkg/provider/garden"
 	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot"
 	"github.com/gardener/diki/pkg/ruleset"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom"
 )
 
 // GardenProviderFromConfig retuns a Provider from a [ProviderConfig].
@@ -38,6 +39,14 @@ func GardenProviderFromConfig(conf config.ProviderConfig) (provider.Provider, er
 			setLoggerHardened := securityhardenedshoot.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
 			setLoggerHardened(ruleset)
 			rulesets = append(rulesets, ruleset)
+		case custom.RulesetID:
+			ruleset, err := custom.FromGenericConfig(rulesetConfig, p.Config)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerCustom := custom.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerCustom(ruleset)
+			rulesets = append(rulesets, ruleset)
 		default:
 			return nil, fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
 		}
@@ -55,6 +64,8 @@ func gardenGetSupportedVersions(ruleset string) []string {
 	switch ruleset {
 	case securityhardenedshoot.RulesetID:
 		return securityhardenedshoot.SupportedVersions
+	case custom.RulesetID:
+		return custom.SupportedVersions
 	default:
 		return nil
 	}
@@ -72,6 +83,10 @@ func GardenProviderMetadata() metadata.ProviderDetailed {
 				ID:   securityhardenedshoot.RulesetID,
 				Name: securityhardenedshoot.RulesetName,
 			},
+			{
+				ID:   custom.RulesetID,
+				Name: custom.RulesetName,
+			},
 		},
 	}
 
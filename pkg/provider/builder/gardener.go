@@ -9,8 +9,10 @@ import (
 	"log/slog"
 
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
 	"github.com/gardener/diki/pkg/metadata"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/provider/gardener"
@@ -30,11 +32,20 @@ func GardenerProviderFromConfig(conf config.ProviderConfig) (provider.Provider,
 	providerLogger := slog.Default().With("provider", p.ID())
 	setLoggerFunc := gardener.WithLogger(providerLogger)
 	setLoggerFunc(p)
+	// ops pods are limited per cluster across all rulesets
+	shootOpsPodContext, err := newOpsPodContext(p.ShootConfig, p.AdditionalOpsPodLabels, p.OpsPod, providerLogger)
+	if err != nil {
+		return nil, err
+	}
+	seedOpsPodContext, err := newOpsPodContext(p.SeedConfig, p.AdditionalOpsPodLabels, p.OpsPod, providerLogger)
+	if err != nil {
+		return nil, err
+	}
 	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
 	for _, rulesetConfig := range conf.Rulesets {
 		switch rulesetConfig.ID {
 		case disak8sstig.RulesetID:
-			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.ShootConfig, p.SeedConfig, p.Args.ShootNamespace)
+			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.OpsPod, p.ShootConfig, p.SeedConfig, p.Args.ShootNamespace, shootOpsPodContext, seedOpsPodContext)
 			if err != nil {
 				return nil, err
 			}
@@ -63,6 +74,16 @@ func setConfigDefaults(config *rest.Config) {
 	}
 }
 
+// newOpsPodContext creates the ops pod context of a cluster. It is shared by all rulesets of a provider,
+// so that the configured limits of ops pods apply to the whole cluster.
+func newOpsPodContext(config *rest.Config, additionalOpsPodLabels map[string]string, opsPod *pod.OpsPodConfig, logger *slog.Logger) (*pod.LimitedPodContext, error) {
+	c, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return pod.NewOpsPodContext(c, config, additionalOpsPodLabels, opsPod, logger)
+}
+
 // gardenerGetSupportedVersions returns the Supported Versions of a specific ruleset that is supported by the Gardener provider.
 func gardenerGetSupportedVersions(ruleset string) []string {
 	switch ruleset {
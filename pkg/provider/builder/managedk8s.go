@@ -15,6 +15,7 @@ import (
 	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig"
 	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s"
 	"github.com/gardener/diki/pkg/ruleset"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom"
 )
 
 // ManagedK8SProviderFromConfig retuns a Provider from a [ProviderConfig].
@@ -28,11 +29,16 @@ func ManagedK8SProviderFromConfig(conf config.ProviderConfig) (provider.Provider
 	providerLogger := slog.Default().With("provider", p.ID())
 	setLoggerFunc := managedk8s.WithLogger(providerLogger)
 	setLoggerFunc(p)
+	// ops pods are limited per cluster across all rulesets
+	opsPodContext, err := newOpsPodContext(p.Config, p.AdditionalOpsPodLabels, p.OpsPod, providerLogger)
+	if err != nil {
+		return nil, err
+	}
 	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
 	for _, rulesetConfig := range conf.Rulesets {
 		switch rulesetConfig.ID {
 		case disak8sstig.RulesetID:
-			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.Config)
+			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.OpsPod, p.Config, opsPodContext)
 			if err != nil {
 				return nil, err
 			}
@@ -47,6 +53,14 @@ func ManagedK8SProviderFromConfig(conf config.ProviderConfig) (provider.Provider
 			setLoggerHardened := securityhardenedk8s.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
 			setLoggerHardened(ruleset)
 			rulesets = append(rulesets, ruleset)
+		case custom.RulesetID:
+			ruleset, err := custom.FromGenericConfig(rulesetConfig, p.Config)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerCustom := custom.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerCustom(ruleset)
+			rulesets = append(rulesets, ruleset)
 		default:
 			return nil, fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
 		}
@@ -66,6 +80,8 @@ func managedK8SGetSupportedVersions(ruleset string) []string {
 		return securityhardenedk8s.SupportedVersions
 	case disak8sstig.RulesetID:
 		return disak8sstig.SupportedVersions
+	case custom.RulesetID:
+		return custom.SupportedVersions
 	default:
 		return nil
 	}
@@ -87,6 +103,10 @@ func ManagedK8SProviderMetadata() metadata.ProviderDetailed {
 				ID:   disak8sstig.RulesetID,
 				Name: disak8sstig.RulesetName,
 			},
+			{
+				ID:   custom.RulesetID,
+				Name: custom.RulesetName,
+			},
 		},
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/gardener/diki/pkg/provider/virtualgarden"
 	"github.com/gardener/diki/pkg/provider/virtualgarden/ruleset/disak8sstig"
 	"github.com/gardener/diki/pkg/ruleset"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom"
 )
 
 // VirtualGardenProviderFromConfig retuns a Provider from a [ProviderConfig].
@@ -27,17 +28,30 @@ func VirtualGardenProviderFromConfig(conf config.ProviderConfig) (provider.Provi
 	providerLogger := slog.Default().With("provider", p.ID())
 	setLoggerFunc := virtualgarden.WithLogger(providerLogger)
 	setLoggerFunc(p)
+	// ops pods are limited per cluster across all rulesets
+	runtimeOpsPodContext, err := newOpsPodContext(p.RuntimeConfig, p.AdditionalOpsPodLabels, p.OpsPod, providerLogger)
+	if err != nil {
+		return nil, err
+	}
 	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
 	for _, rulesetConfig := range conf.Rulesets {
 		switch rulesetConfig.ID {
 		case disak8sstig.RulesetID:
-			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.RuntimeConfig)
+			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.OpsPod, p.RuntimeConfig, runtimeOpsPodContext)
 			if err != nil {
 				return nil, err
 			}
 			setLoggerDISA := disak8sstig.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
 			setLoggerDISA(ruleset)
 			rulesets = append(rulesets, ruleset)
+		case custom.RulesetID:
+			ruleset, err := custom.FromGenericConfig(rulesetConfig, p.RuntimeConfig)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerCustom := custom.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerCustom(ruleset)
+			rulesets = append(rulesets, ruleset)
 		default:
 			return nil, fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
 		}
@@ -55,6 +69,8 @@ func virtualGardenGetSupportedVersions(ruleset string) []string {
 	switch ruleset {
 	case disak8sstig.RulesetID:
 		return disak8sstig.SupportedVersions
+	case custom.RulesetID:
+		return custom.SupportedVersions
 	default:
 		return nil
 	}
@@ -72,6 +88,10 @@ func VirtualGardenProviderMetadata() metadata.ProviderDetailed {
 				ID:   disak8sstig.RulesetID,
 				Name: disak8sstig.RulesetName,
 			},
+			{
+				ID:   custom.RulesetID,
+				Name: custom.RulesetName,
+			},
 		},
 	}
 
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gardener/diki/pkg/config"
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/preflight"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
@@ -42,7 +43,10 @@ type providerArgs struct {
 	KubeconfigPath string `json:"kubeconfigPath" yaml:"kubeconfigPath"`
 }
 
-var _ provider.Provider = &Provider{}
+var (
+	_ provider.ClusterProvider   = &Provider{}
+	_ provider.PreflightProvider = &Provider{}
+)
 
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
@@ -120,6 +124,16 @@ func (p *Provider) Name() string {
 	return p.name
 }
 
+// RESTConfig returns the config of the garden cluster.
+func (p *Provider) RESTConfig() *rest.Config {
+	return p.Config
+}
+
+// PreflightRequirements returns the requirements of the Provider's rulesets for the garden cluster.
+func (p *Provider) PreflightRequirements() []preflight.Requirement {
+	return sharedprovider.PreflightRequirements([]preflight.Requirement{{Cluster: "garden", Config: p.Config}}, p.rulesets)
+}
+
 // Metadata returns the metadata of the Provider.
 func (p *Provider) Metadata() map[string]string {
 	if p.metadata == nil {
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package securityhardenedshoot
+
+import (
+	authorizationv1 "k8s.io/api/authorization/v1"
+
+	"github.com/gardener/diki/pkg/preflight"
+)
+
+var _ preflight.Ruleset = &Ruleset{}
+
+// PreflightRequirements returns the requirements of the Ruleset for the garden cluster.
+func (r *Ruleset) PreflightRequirements() []preflight.Requirement {
+	return []preflight.Requirement{
+		{
+			Cluster: "garden",
+			Config:  r.Config,
+			Permissions: []authorizationv1.ResourceAttributes{
+				{Namespace: r.args.ProjectNamespace, Verb: "get", Group: "core.gardener.cloud", Resource: "shoots", Name: r.args.ShootName},
+				{Namespace: r.args.ProjectNamespace, Verb: "get", Resource: "configmaps"},
+				{Namespace: r.args.ProjectNamespace, Verb: "get", Group: "core.gardener.cloud", Resource: "namespacedcloudprofiles"},
+				{Verb: "get", Group: "core.gardener.cloud", Resource: "cloudprofiles"},
+			},
+		},
+	}
+}
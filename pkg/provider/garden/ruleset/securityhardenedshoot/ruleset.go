@@ -9,7 +9,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 
 	"github.com/gardener/diki/pkg/config"
@@ -35,18 +37,22 @@ var (
 
 // Ruleset implements Security Hardened Shoot Cluster.
 type Ruleset struct {
-	version    string
-	rules      map[string]rule.Rule
-	Config     *rest.Config
-	numWorkers int
-	args       Args
-	logger     *slog.Logger
+	version      string
+	rules        map[string]rule.Rule
+	Config       *rest.Config
+	numWorkers   int
+	args         Args
+	ruleTimeouts sharedruleset.RuleTimeouts
+	exceptions   []config.ExceptionConfig
+	logger       *slog.Logger
 }
 
 // Args are Ruleset specific arguments.
 type Args struct {
 	ShootName        string `json:"shootName" yaml:"shootName"`
 	ProjectNamespace string `json:"projectNamespace" yaml:"projectNamespace"`
+	// RuleTimeout is the max duration of a single rule run. It can be overwritten per rule.
+	RuleTimeout *metav1.Duration `json:"ruleTimeout" yaml:"ruleTimeout"`
 }
 
 // New creates a new Ruleset.
@@ -108,6 +114,19 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.C
 		ruleOptions[opt.RuleID] = opt
 	}
 
+	ruleTimeouts, err := sharedruleset.NewRuleTimeouts(rulesetArgs.RuleTimeout, ruleOptions)
+	if err != nil {
+		return nil, err
+	}
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
+	if err := sharedruleset.ValidateExceptions(rulesetConfig.Exceptions); err != nil {
+		return nil, fmt.Errorf("invalid exceptions for ruleset with id %s and version %s: %w", rulesetConfig.ID, rulesetConfig.Version, err)
+	}
+	setExceptions := WithExceptions(rulesetConfig.Exceptions)
+	setExceptions(ruleset)
+
 	switch rulesetConfig.Version {
 	case "v0.1.0":
 		if err := ruleset.registerV01Rules(ruleOptions); err != nil {
@@ -130,6 +149,13 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.C
 		return nil, fmt.Errorf("unknown ruleset %s version: %s", rulesetConfig.ID, rulesetConfig.Version)
 	}
 
+	pluginRules, err := sharedruleset.PluginRules(rulesetConfig.Plugins, managedConfig, ruleOptions, ruleset.Logger())
+	if err != nil {
+		return nil, err
+	}
+	if err := ruleset.AddRules(pluginRules...); err != nil {
+		return nil, err
+	}
 	return ruleset, nil
 }
 
@@ -140,12 +166,21 @@ func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, erro
 		return rule.RuleResult{}, fmt.Errorf("rule with id %s is not registered in the ruleset", id)
 	}
 
-	return rr.Run(ctx)
+	res, err := sharedruleset.RunRule(ctx, rr, r.ruleTimeouts.Timeout(id), r.Logger())
+	if err != nil {
+		res = sharedruleset.ErroredRuleResult(rr, err)
+	}
+	documentation.Document(&res)
+	sharedruleset.ApplyRuleExceptions(&res, r.exceptions, time.Now())
+	return res, err
 }
 
 // Run executes all known Rules of the Ruleset.
 func (r *Ruleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
-	return sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.Logger())
+	res, err := sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.ruleTimeouts, r.Logger())
+	documentation.DocumentRuleset(&res)
+	sharedruleset.ApplyExceptions(&res, r.exceptions, time.Now())
+	return res, err
 }
 
 // AddRules adds Rules to the Ruleset.
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package securityhardenedshoot_test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("ruleset", func() {
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		logger        *slog.Logger
+		managedConfig *rest.Config
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+		logger = slog.New(slog.DiscardHandler)
+		// the rules are run against an unreachable cluster and return errored results
+		managedConfig = &rest.Config{Host: "https://127.0.0.1:1"}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("should document every registered rule with a STIG Vuln ID, CCI and NIST SP 800-53 reference", func() {
+		for _, version := range securityhardenedshoot.SupportedVersions {
+			rulesetConfig := config.RulesetConfig{
+				ID:      securityhardenedshoot.RulesetID,
+				Version: version,
+				Args:    map[string]any{"shootName": "foo", "projectNamespace": "garden-bar"},
+			}
+			r, err := securityhardenedshoot.FromGenericConfig(rulesetConfig, managedConfig, logger)
+			Expect(err).ToNot(HaveOccurred())
+			setLogger := securityhardenedshoot.WithLogger(logger)
+			setLogger(r)
+
+			res, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RuleResults).ToNot(BeEmpty())
+			for _, ruleResult := range res.RuleResults {
+				Expect(ruleResult.Documentation).ToNot(BeNil(), "rule %s of version %s is not documented", ruleResult.RuleID, version)
+				var referenceTypes []rule.ReferenceType
+				for _, reference := range ruleResult.Documentation.References {
+					referenceTypes = append(referenceTypes, reference.Type)
+				}
+				Expect(referenceTypes).To(ContainElements(rule.ReferenceSTIGVulnID, rule.ReferenceCCI, rule.ReferenceNIST80053), "rule %s of version %s", ruleResult.RuleID, version)
+			}
+		}
+	})
+})
@@ -14,6 +14,8 @@ import (
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot/rules"
 	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/retry"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 )
 
@@ -109,6 +111,7 @@ func (r *Ruleset) registerV02Rules(ruleOptions map[string]config.RuleOptionsConf
 		},
 	}
 
+	logger := r.Logger()
 	for i, r := range rules {
 		var severityLevel rule.SeverityLevel
 		if severity, ok := r.(rule.Severity); !ok {
@@ -118,8 +121,15 @@ func (r *Ruleset) registerV02Rules(ruleOptions map[string]config.RuleOptionsConf
 		}
 
 		opt, found := ruleOptions[r.ID()]
-		if found && opt.Skip != nil && opt.Skip.Enabled {
+		switch {
+		case found && opt.Skip != nil && opt.Skip.Enabled:
 			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+		case found && len(opt.RetryPatterns) > 0:
+			retryableRule, err := sharedruleset.WithRetryPatterns(r, opt.RetryPatterns, retry.WithLogger(logger.With("rule_id", r.ID())))
+			if err != nil {
+				return err
+			}
+			rules[i] = retryableRule
 		}
 	}
 
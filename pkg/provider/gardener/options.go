@@ -8,6 +8,8 @@ import (
 	"log/slog"
 
 	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
 )
 
 // CreateOption is a function that acts on a Provider
@@ -35,6 +37,13 @@ func WithAdditionalOpsPodLabels(labels map[string]string) CreateOption {
 	}
 }
 
+// WithOpsPod sets the OpsPod config of a [Provider].
+func WithOpsPod(opsPod *pod.OpsPodConfig) CreateOption {
+	return func(p *Provider) {
+		p.OpsPod = opsPod
+	}
+}
+
 // WithShootConfig sets the ShootConfig of a Provider.
 func WithShootConfig(config *rest.Config) CreateOption {
 	return func(p *Provider) {
@@ -11,10 +11,13 @@ import (
 	"fmt"
 	"log/slog"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
 
 	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/preflight"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
@@ -33,6 +36,7 @@ const (
 type Provider struct {
 	id, name                string
 	AdditionalOpsPodLabels  map[string]string
+	OpsPod                  *pod.OpsPodConfig
 	ShootConfig, SeedConfig *rest.Config
 	Args                    Args
 	rulesets                map[string]ruleset.Ruleset
@@ -42,6 +46,7 @@ type Provider struct {
 
 type providerArgs struct {
 	AdditionalOpsPodLabels map[string]string `json:"additionalOpsPodLabels" yaml:"additionalOpsPodLabels"`
+	OpsPod                 *pod.OpsPodConfig `json:"opsPod" yaml:"opsPod"`
 	ShootKubeconfigPath    string            `json:"shootKubeconfigPath" yaml:"shootKubeconfigPath"`
 	SeedKubeconfigPath     string            `json:"seedKubeconfigPath" yaml:"seedKubeconfigPath"`
 	ShootName              string            `json:"shootName" yaml:"shootName"`
@@ -54,7 +59,11 @@ type Args struct {
 	ShootNamespace string
 }
 
-var _ provider.Provider = &Provider{}
+var (
+	_ provider.ClusterProvider      = &Provider{}
+	_ provider.MultiClusterProvider = &Provider{}
+	_ provider.PreflightProvider    = &Provider{}
+)
 
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
@@ -150,6 +159,24 @@ func (p *Provider) Name() string {
 	return p.name
 }
 
+// RESTConfig returns the config of the shoot cluster.
+func (p *Provider) RESTConfig() *rest.Config {
+	return p.ShootConfig
+}
+
+// RESTConfigCluster returns the value of the "cluster" target attribute of the shoot cluster.
+func (p *Provider) RESTConfigCluster() string {
+	return "shoot"
+}
+
+// PreflightRequirements returns the requirements of the Provider's rulesets for the shoot and seed clusters.
+func (p *Provider) PreflightRequirements() []preflight.Requirement {
+	return sharedprovider.PreflightRequirements([]preflight.Requirement{
+		{Cluster: "shoot", Config: p.ShootConfig},
+		{Cluster: "seed", Config: p.SeedConfig},
+	}, p.rulesets)
+}
+
 // Metadata returns the metadata of the Provider.
 func (p *Provider) Metadata() map[string]string {
 	if p.metadata == nil {
@@ -170,6 +197,10 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		return nil, err
 	}
 
+	if err := providerGardenerArgs.OpsPod.Validate(field.NewPath("args", "opsPod")).ToAggregate(); err != nil {
+		return nil, err
+	}
+
 	shootKubeConfig, err := kubeutils.RESTConfigFromFile(providerGardenerArgs.ShootKubeconfigPath)
 	if err != nil {
 		return nil, err
@@ -189,6 +220,7 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		WithID(providerConf.ID),
 		WithName(providerConf.Name),
 		WithAdditionalOpsPodLabels(providerGardenerArgs.AdditionalOpsPodLabels),
+		WithOpsPod(providerGardenerArgs.OpsPod),
 		WithSeedConfig(seedKubeConfig),
 		WithShootConfig(shootKubeConfig),
 		WithMetadata(providerConf.Metadata),
@@ -8,6 +8,10 @@ import (
 	"log/slog"
 
 	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
 )
 
 // CreateOption is a function that acts on a [Ruleset]
@@ -28,6 +32,13 @@ func WithAdditionalOpsPodLabels(labels map[string]string) CreateOption {
 	}
 }
 
+// WithOpsPod sets the OpsPod config of a [Ruleset].
+func WithOpsPod(opsPod *pod.OpsPodConfig) CreateOption {
+	return func(r *Ruleset) {
+		r.OpsPod = opsPod
+	}
+}
+
 // WithShootConfig sets the ShootConfig of a [Ruleset].
 func WithShootConfig(config *rest.Config) CreateOption {
 	return func(r *Ruleset) {
@@ -49,17 +60,47 @@ func WithShootNamespace(shootNamespace string) CreateOption {
 	}
 }
 
+// WithShootOpsPodContext sets the ShootOpsPodContext of a [Ruleset].
+func WithShootOpsPodContext(opsPodContext *pod.LimitedPodContext) CreateOption {
+	return func(r *Ruleset) {
+		r.ShootOpsPodContext = opsPodContext
+	}
+}
+
+// WithSeedOpsPodContext sets the SeedOpsPodContext of a [Ruleset].
+func WithSeedOpsPodContext(opsPodContext *pod.LimitedPodContext) CreateOption {
+	return func(r *Ruleset) {
+		r.SeedOpsPodContext = opsPodContext
+	}
+}
+
 // WithArgs sets the args of a [Ruleset].
 func WithArgs(args Args) CreateOption {
 	return func(r *Ruleset) {
-		switch {
-		case args.MaxRetries == nil:
-			return
-		case *args.MaxRetries < 0:
-			panic("max retries should not be a negative number")
-		default:
+		if args.MaxRetries != nil {
+			if *args.MaxRetries < 0 {
+				panic("max retries should not be a negative number")
+			}
 			r.args.MaxRetries = args.MaxRetries
 		}
+		if args.RetryBaseWait != nil {
+			if args.RetryBaseWait.Duration < 0 {
+				panic("retry base wait should not be a negative duration")
+			}
+			r.args.RetryBaseWait = args.RetryBaseWait
+		}
+		if args.RetryMaxWait != nil {
+			if args.RetryMaxWait.Duration < 0 {
+				panic("retry max wait should not be a negative duration")
+			}
+			r.args.RetryMaxWait = args.RetryMaxWait
+		}
+		if args.RetryJitter != nil {
+			if *args.RetryJitter < 0 {
+				panic("retry jitter should not be a negative number")
+			}
+			r.args.RetryJitter = args.RetryJitter
+		}
 	}
 }
 
@@ -73,6 +114,20 @@ func WithNumberOfWorkers(numWorkers int) CreateOption {
 	}
 }
 
+// WithRuleTimeouts sets the rule timeouts of a [Ruleset].
+func WithRuleTimeouts(ruleTimeouts sharedruleset.RuleTimeouts) CreateOption {
+	return func(r *Ruleset) {
+		r.ruleTimeouts = ruleTimeouts
+	}
+}
+
+// WithExceptions sets the exceptions of a [Ruleset].
+func WithExceptions(exceptions []config.ExceptionConfig) CreateOption {
+	return func(r *Ruleset) {
+		r.exceptions = exceptions
+	}
+}
+
 // WithLogger the logger of a [Ruleset].
 func WithLogger(logger *slog.Logger) CreateOption {
 	return func(r *Ruleset) {
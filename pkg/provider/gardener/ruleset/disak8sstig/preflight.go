@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disak8sstig
+
+import (
+	authorizationv1 "k8s.io/api/authorization/v1"
+
+	"github.com/gardener/diki/pkg/preflight"
+)
+
+var _ preflight.Ruleset = &Ruleset{}
+
+// PreflightRequirements returns the requirements of the Ruleset for the shoot and seed clusters.
+func (r *Ruleset) PreflightRequirements() []preflight.Requirement {
+	return []preflight.Requirement{
+		{
+			Cluster: "shoot",
+			Config:  r.ShootConfig,
+			Permissions: []authorizationv1.ResourceAttributes{
+				{Verb: "list", Resource: "namespaces"},
+				{Verb: "list", Resource: "pods"},
+				{Verb: "list", Group: "apps", Resource: "replicasets"},
+				{Verb: "list", Resource: "nodes"},
+				{Verb: "get", Resource: "nodes", Subresource: "proxy"},
+			},
+			OpsPod: &preflight.OpsPodRequirement{Namespace: r.OpsPod.PodNamespace("kube-system"), AdditionalLabels: r.AdditionalOpsPodLabels, Config: r.OpsPod, InstanceIDs: []string{r.instanceID}},
+		},
+		{
+			Cluster: "seed",
+			Config:  r.SeedConfig,
+			Permissions: []authorizationv1.ResourceAttributes{
+				{Namespace: r.shootNamespace, Verb: "list", Resource: "pods"},
+				{Namespace: r.shootNamespace, Verb: "get", Resource: "configmaps"},
+				{Namespace: r.shootNamespace, Verb: "get", Resource: "secrets"},
+				{Namespace: r.shootNamespace, Verb: "get", Group: "apps", Resource: "deployments"},
+				{Namespace: r.shootNamespace, Verb: "get", Group: "apps", Resource: "statefulsets"},
+			},
+			OpsPod: &preflight.OpsPodRequirement{Namespace: r.OpsPod.PodNamespace("kube-system"), AdditionalLabels: r.AdditionalOpsPodLabels, Config: r.OpsPod, InstanceIDs: []string{r.instanceID}},
+		},
+	}
+}
@@ -246,6 +246,7 @@ var _ = Describe("#242400", func() {
 			ControlPlaneClient:    fakeControlPlaneClient,
 			ControlPlaneNamespace: controlPlaneNamespace,
 			ClusterPodContext:     fakePodContext,
+			OpsPodNamespace:       "kube-system",
 			ClusterV1RESTClient:   fakeRESTClient,
 		}
 		ruleResult, err := r.Run(ctx)
@@ -318,6 +319,7 @@ var _ = Describe("#242400", func() {
 			ControlPlaneClient:    fakeControlPlaneClient,
 			ControlPlaneNamespace: controlPlaneNamespace,
 			ClusterPodContext:     fakePodContext,
+			OpsPodNamespace:       "kube-system",
 			ClusterV1RESTClient:   fakeRESTClient,
 		}
 		ruleResult, err := r.Run(ctx)
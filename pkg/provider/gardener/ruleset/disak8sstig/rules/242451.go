@@ -10,11 +10,9 @@ import (
 	"fmt"
 	"slices"
 	"strings"
-	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/component-base/version"
@@ -43,6 +41,7 @@ type Rule242451 struct {
 	ControlPlaneNamespace  string
 	ControlPlanePodContext pod.PodContext
 	ClusterPodContext      pod.PodContext
+	OpsPodNamespace        string
 	Options                *Options242451
 	Logger                 provider.Logger
 }
@@ -158,7 +157,7 @@ func (r *Rule242451) Run(ctx context.Context) (rule.RuleResult, error) {
 
 				for nodeName, pods := range groupedPods {
 					checkResults = append(checkResults,
-						r.checkPods(ctx, r.ControlPlaneClient, r.ControlPlanePodContext, pods, seedReplicaSets, nodeName, image.String(), fileOwnerOptions, seedTarget)...)
+						r.checkPods(ctx, r.ControlPlanePodContext, pods, seedReplicaSets, nodeName, image.String(), fileOwnerOptions, seedTarget)...)
 				}
 			}
 		}
@@ -217,7 +216,7 @@ func (r *Rule242451) Run(ctx context.Context) (rule.RuleResult, error) {
 
 		for nodeName, pods := range groupedShootPods {
 			checkResults = append(checkResults,
-				r.checkPods(ctx, r.ClusterClient, r.ClusterPodContext, pods, shootReplicaSets, nodeName, image.String(), fileOwnerOptions, shootTarget)...)
+				r.checkPods(ctx, r.ClusterPodContext, pods, shootReplicaSets, nodeName, image.String(), fileOwnerOptions, shootTarget)...)
 		}
 	}
 
@@ -226,7 +225,6 @@ func (r *Rule242451) Run(ctx context.Context) (rule.RuleResult, error) {
 
 func (r *Rule242451) checkPods(
 	ctx context.Context,
-	c client.Client,
 	pc pod.PodContext,
 	pods []corev1.Pod,
 	replicaSets []appsv1.ReplicaSet,
@@ -236,40 +234,15 @@ func (r *Rule242451) checkPods(
 	var (
 		checkResults     []rule.CheckResult
 		podName          = fmt.Sprintf("diki-%s-%s", r.ID(), sharedrules.Generator.Generate(10))
-		execPodTarget    = target.With("name", podName, "namespace", "kube-system", "kind", "Pod")
 		additionalLabels = map[string]string{pod.LabelInstanceID: r.InstanceID}
 	)
 
-	defer func() {
-		timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-		defer cancel()
-
-		if err := pc.Delete(timeoutCtx, podName, "kube-system"); err != nil {
-			r.Logger.Error(err.Error())
-		}
-	}()
-
-	podExecutor, err := pc.Create(ctx, pod.NewPrivilegedPod(podName, "kube-system", imageName, nodeName, additionalLabels))
+	podExecutor, err := pc.Create(ctx, pod.NewPrivilegedPod(podName, r.OpsPodNamespace, imageName, nodeName, additionalLabels))
 	if err != nil {
-		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target.With("name", nodeName, "kind", "Node"))}
 	}
-
-	execPod := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
-			Namespace: "kube-system",
-		},
-	}
-
-	if err := c.Get(ctx, client.ObjectKeyFromObject(execPod), execPod); err != nil {
-		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
-	}
-
-	var (
-		execContainerID     = execPod.Status.ContainerStatuses[0].ContainerID
-		execBaseContainerID = strings.Split(execContainerID, "//")[1]
-		execContainerPath   = fmt.Sprintf("/run/containerd/io.containerd.runtime.v2.task/k8s.io/%s/rootfs", execBaseContainerID)
-	)
+	execPod := podExecutor.Pod()
+	execPodTarget := target.With("name", execPod.Name, "namespace", execPod.Namespace, "kind", "Pod")
 
 	slices.SortFunc(pods, func(a, b corev1.Pod) int {
 		return cmp.Compare(a.Name, b.Name)
@@ -277,7 +250,7 @@ func (r *Rule242451) checkPods(
 
 	for _, pod := range pods {
 		excludedSources := []string{"/lib/modules", "/usr/share/ca-certificates", "/var/log/journal", "/var/run/dbus/system_bus_socket"}
-		mappedFileStats, err := intutils.GetMountedFilesStats(ctx, execContainerPath, podExecutor, pod, excludedSources)
+		mappedFileStats, err := intutils.GetMountedFilesStats(ctx, podExecutor, pod, excludedSources)
 		if err != nil {
 			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
 		}
@@ -338,22 +311,15 @@ func (r *Rule242451) checkKubelet(
 		pkiDirs           = map[string]struct{}{}
 		podName           = fmt.Sprintf("diki-%s-%s", r.ID(), sharedrules.Generator.Generate(10))
 		nodeTarget        = target.With("name", nodeName, "kind", "Node")
-		execPodTarget     = target.With("name", podName, "namespace", "kube-system", "kind", "Pod")
 		additionalLabels  = map[string]string{pod.LabelInstanceID: r.InstanceID}
 	)
 
-	defer func() {
-		timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-		defer cancel()
-
-		if err := r.ClusterPodContext.Delete(timeoutCtx, podName, "kube-system"); err != nil {
-			r.Logger.Error(err.Error())
-		}
-	}()
-	podExecutor, err := r.ClusterPodContext.Create(ctx, pod.NewPrivilegedPod(podName, "kube-system", imageName, nodeName, additionalLabels))
+	podExecutor, err := r.ClusterPodContext.Create(ctx, pod.NewPrivilegedPod(podName, r.OpsPodNamespace, imageName, nodeName, additionalLabels))
 	if err != nil {
-		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), nodeTarget)}
 	}
+	execPod := podExecutor.Pod()
+	execPodTarget := target.With("name", execPod.Name, "namespace", execPod.Namespace, "kind", "Pod")
 
 	rawKubeletCommand, err := kubeutils.GetKubeletCommand(ctx, podExecutor)
 	if err != nil {
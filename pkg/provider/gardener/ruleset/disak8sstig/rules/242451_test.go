@@ -368,6 +368,7 @@ tlsCertFile: /var/lib/certs/tls.crt`
 			ControlPlaneNamespace:  Namespace,
 			ControlPlanePodContext: fakeControlPlanePodContext,
 			ClusterPodContext:      fakeClusterPodContext,
+			OpsPodNamespace:        "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -407,6 +408,7 @@ tlsCertFile: /var/lib/certs/tls.crt`
 				ControlPlaneNamespace:  Namespace,
 				ControlPlanePodContext: fakeControlPlanePodContext,
 				ClusterPodContext:      fakeClusterPodContext,
+				OpsPodNamespace:        "kube-system",
 				Options:                &options,
 			}
 
@@ -370,6 +370,7 @@ tlsCertFile: /var/lib/certs/tls.crt`
 			ControlPlaneNamespace:  Namespace,
 			ControlPlanePodContext: fakeControlPlanePodContext,
 			ClusterPodContext:      fakeClusterPodContext,
+			OpsPodNamespace:        "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -409,6 +410,7 @@ tlsCertFile: /var/lib/certs/tls.crt`
 				ControlPlaneNamespace:  Namespace,
 				ControlPlanePodContext: fakeControlPlanePodContext,
 				ClusterPodContext:      fakeClusterPodContext,
+				OpsPodNamespace:        "kube-system",
 				Options:                option,
 			}
 
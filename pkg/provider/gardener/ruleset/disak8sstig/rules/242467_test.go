@@ -364,6 +364,7 @@ tlsCertFile: /var/lib/certs/tls.crt`
 			ControlPlaneNamespace:  Namespace,
 			ControlPlanePodContext: fakeControlPlanePodContext,
 			ClusterPodContext:      fakeClusterPodContext,
+			OpsPodNamespace:        "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -403,6 +404,7 @@ tlsCertFile: /var/lib/certs/tls.crt`
 				ControlPlaneNamespace:  Namespace,
 				ControlPlanePodContext: fakeControlPlanePodContext,
 				ClusterPodContext:      fakeClusterPodContext,
+				OpsPodNamespace:        "kube-system",
 				Options:                option,
 			}
 
@@ -14,10 +14,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
-	"github.com/gardener/diki/pkg/kubernetes/pod"
 	"github.com/gardener/diki/pkg/provider/gardener/ruleset/disak8sstig/rules"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/rule/retry"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
 	option "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/retryerrors"
 	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
@@ -61,16 +61,18 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		return err
 	}
 
-	shootPodContext, err := pod.NewSimplePodContext(shootClient, r.ShootConfig, r.AdditionalOpsPodLabels)
+	shootPodContext, err := r.newPodContext(r.ShootOpsPodContext, shootClient, r.ShootConfig)
 	if err != nil {
 		return err
 	}
 
-	seedPodContext, err := pod.NewSimplePodContext(seedClient, r.SeedConfig, r.AdditionalOpsPodLabels)
+	seedPodContext, err := r.newPodContext(r.SeedOpsPodContext, seedClient, r.SeedConfig)
 	if err != nil {
 		return err
 	}
 
+	opsPodNamespace := r.OpsPod.PodNamespace("kube-system")
+
 	shootClientSet, err := kubernetes.NewForConfig(r.ShootConfig)
 	if err != nil {
 		return err
@@ -125,7 +127,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		*retryerrors.ContainerFileNotFoundOnNodeRegexp,
 		*retryerrors.ContainerNotReadyRegexp,
 		*retryerrors.OpsPodNotFoundRegexp,
-		*retryerrors.ObjectNotFoundRegexp,
+		*retryerrors.ContainerNotFoundByRuntimeRegexp,
 	)
 
 	// Gardener images use distroless nonroot user with ID 65532
@@ -180,30 +182,34 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242393)),
 			retry.WithBaseRule(&sharedrules.Rule242393{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242393),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242393),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242393{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 				},
 			}),
 			retry.WithRetryCondition(rcOpsPod),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242394)),
 			retry.WithBaseRule(&sharedrules.Rule242394{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242394),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242394),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242394{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 				},
 			}),
 			retry.WithRetryCondition(rcOpsPod),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		&sharedrules.Rule242395{Client: shootClient},
 		rule.NewSkipRule(
@@ -241,28 +247,32 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				ControlPlaneClient:    seedClient,
 				ClusterClient:         shootClient,
 				ClusterPodContext:     shootPodContext,
+				OpsPodNamespace:       opsPodNamespace,
 				ClusterV1RESTClient:   shootClientSet.CoreV1().RESTClient(),
 				ControlPlaneNamespace: r.shootNamespace,
 				Options:               opts242400,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		&sharedrules.Rule242402{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242403{Client: seedClient, Namespace: r.shootNamespace},
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242404)),
 			retry.WithBaseRule(&sharedrules.Rule242404{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242404),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242404),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242404{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 				},
 			}),
 			retry.WithRetryCondition(rcOpsPod),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID242405,
@@ -274,10 +284,11 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242406)),
 			retry.WithBaseRule(&sharedrules.Rule242406{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242406),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242406),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242406{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 					FileOwnerOptions:  gardenerFileOwnerOptions,
@@ -285,20 +296,23 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242407)),
 			retry.WithBaseRule(&sharedrules.Rule242407{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242407),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242407),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242407{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 				},
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID242408,
@@ -423,75 +437,86 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242445)),
 			retry.WithBaseRule(&sharedrules.Rule242445{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242445),
-				InstanceID: r.instanceID,
-				Client:     seedClient,
-				PodContext: seedPodContext,
-				Namespace:  r.shootNamespace,
-				Options:    opts242445,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242445),
+				InstanceID:      r.instanceID,
+				Client:          seedClient,
+				PodContext:      seedPodContext,
+				OpsPodNamespace: opsPodNamespace,
+				Namespace:       r.shootNamespace,
+				Options:         opts242445,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242446)),
 			retry.WithBaseRule(&sharedrules.Rule242446{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242446),
-				InstanceID: r.instanceID,
-				Client:     seedClient,
-				PodContext: seedPodContext,
-				Namespace:  r.shootNamespace,
-				Options:    opts242446,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242446),
+				InstanceID:      r.instanceID,
+				Client:          seedClient,
+				PodContext:      seedPodContext,
+				OpsPodNamespace: opsPodNamespace,
+				Namespace:       r.shootNamespace,
+				Options:         opts242446,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242447)),
 			retry.WithBaseRule(&sharedrules.Rule242447{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242447),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242447),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242448)),
 			retry.WithBaseRule(&sharedrules.Rule242448{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242448),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242448),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242448{
 					FileOwnerOptions: gardenerFileOwnerOptions,
 				},
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242449)),
 			retry.WithBaseRule(&sharedrules.Rule242449{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242449),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242449),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242449{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 				},
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242450)),
 			retry.WithBaseRule(&sharedrules.Rule242450{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242450),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242450),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242450{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 					FileOwnerOptions:  gardenerFileOwnerOptions,
@@ -499,6 +524,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242451)),
@@ -509,33 +535,38 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				ClusterClient:          shootClient,
 				ControlPlanePodContext: seedPodContext,
 				ClusterPodContext:      shootPodContext,
+				OpsPodNamespace:        opsPodNamespace,
 				ControlPlaneNamespace:  r.shootNamespace,
 				Options:                opts242451,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242452)),
 			retry.WithBaseRule(&sharedrules.Rule242452{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242452),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242452),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242452{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 				},
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242453)),
 			retry.WithBaseRule(&sharedrules.Rule242453{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242453),
-				InstanceID: r.instanceID,
-				Client:     shootClient,
-				PodContext: shootPodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242453),
+				InstanceID:      r.instanceID,
+				Client:          shootClient,
+				PodContext:      shootPodContext,
+				OpsPodNamespace: opsPodNamespace,
 				Options: &sharedrules.Options242453{
 					NodeGroupByLabels: workerPoolGroupByLabels,
 					FileOwnerOptions:  gardenerFileOwnerOptions,
@@ -543,6 +574,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID242454,
@@ -575,26 +607,30 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242459)),
 			retry.WithBaseRule(&sharedrules.Rule242459{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242459),
-				InstanceID: r.instanceID,
-				Client:     seedClient,
-				PodContext: seedPodContext,
-				Namespace:  r.shootNamespace,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242459),
+				InstanceID:      r.instanceID,
+				Client:          seedClient,
+				PodContext:      seedPodContext,
+				OpsPodNamespace: opsPodNamespace,
+				Namespace:       r.shootNamespace,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242460)),
 			retry.WithBaseRule(&sharedrules.Rule242460{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242460),
-				InstanceID: r.instanceID,
-				Client:     seedClient,
-				PodContext: seedPodContext,
-				Namespace:  r.shootNamespace,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242460),
+				InstanceID:      r.instanceID,
+				Client:          seedClient,
+				PodContext:      seedPodContext,
+				OpsPodNamespace: opsPodNamespace,
+				Namespace:       r.shootNamespace,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		&sharedrules.Rule242461{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242462{Client: seedClient, Namespace: r.shootNamespace},
@@ -616,11 +652,13 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				ClusterClient:          shootClient,
 				ControlPlanePodContext: seedPodContext,
 				ClusterPodContext:      shootPodContext,
+				OpsPodNamespace:        opsPodNamespace,
 				ControlPlaneNamespace:  r.shootNamespace,
 				Options:                opts242466,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242467)),
@@ -631,11 +669,13 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				ClusterClient:          shootClient,
 				ControlPlanePodContext: seedPodContext,
 				ClusterPodContext:      shootPodContext,
+				OpsPodNamespace:        opsPodNamespace,
 				ControlPlaneNamespace:  r.shootNamespace,
 				Options:                opts242467,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		&sharedrules.Rule245541{
 			Client:       shootClient,
@@ -655,6 +695,11 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 	}
 
+	var (
+		maxRetries   = *r.args.MaxRetries
+		retryBackoff = r.retryBackoff()
+		logger       = r.Logger()
+	)
 	for i, r := range rules {
 		var severityLevel rule.SeverityLevel
 		if severity, ok := r.(rule.Severity); !ok {
@@ -664,8 +709,15 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		}
 
 		opt, found := ruleOptions[r.ID()]
-		if found && opt.Skip != nil && opt.Skip.Enabled {
+		switch {
+		case found && opt.Skip != nil && opt.Skip.Enabled:
 			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+		case found && len(opt.RetryPatterns) > 0:
+			retryableRule, err := sharedruleset.WithRetryPatterns(r, opt.RetryPatterns, retry.WithMaxRetries(maxRetries), retry.WithBackoff(retryBackoff), retry.WithLogger(logger.With("rule_id", r.ID())))
+			if err != nil {
+				return err
+			}
+			rules[i] = retryableRule
 		}
 	}
 
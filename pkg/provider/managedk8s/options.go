@@ -7,6 +7,7 @@ package managedk8s
 import (
 	"k8s.io/client-go/rest"
 
+	"github.com/gardener/diki/pkg/kubernetes/pod"
 	"github.com/gardener/diki/pkg/shared/provider"
 )
 
@@ -35,6 +36,13 @@ func WithAdditionalOpsPodLabels(labels map[string]string) CreateOption {
 	}
 }
 
+// WithOpsPod sets the OpsPod config of a [Provider].
+func WithOpsPod(opsPod *pod.OpsPodConfig) CreateOption {
+	return func(p *Provider) {
+		p.OpsPod = opsPod
+	}
+}
+
 // WithConfig sets the Config of a [Provider].
 func WithConfig(config *rest.Config) CreateOption {
 	return func(p *Provider) {
@@ -11,10 +11,13 @@ import (
 	"fmt"
 	"log/slog"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
 
 	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/preflight"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
@@ -33,6 +36,7 @@ const (
 type Provider struct {
 	id, name               string
 	AdditionalOpsPodLabels map[string]string
+	OpsPod                 *pod.OpsPodConfig
 	Config                 *rest.Config
 	rulesets               map[string]ruleset.Ruleset
 	metadata               map[string]string
@@ -41,10 +45,14 @@ type Provider struct {
 
 type providerArgs struct {
 	AdditionalOpsPodLabels map[string]string `json:"additionalOpsPodLabels" yaml:"additionalOpsPodLabels"`
+	OpsPod                 *pod.OpsPodConfig `json:"opsPod" yaml:"opsPod"`
 	KubeconfigPath         string            `json:"kubeconfigPath" yaml:"kubeconfigPath"`
 }
 
-var _ provider.Provider = &Provider{}
+var (
+	_ provider.ClusterProvider   = &Provider{}
+	_ provider.PreflightProvider = &Provider{}
+)
 
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
@@ -117,6 +125,16 @@ func (p *Provider) Name() string {
 	return p.name
 }
 
+// RESTConfig returns the config of the checked cluster.
+func (p *Provider) RESTConfig() *rest.Config {
+	return p.Config
+}
+
+// PreflightRequirements returns the requirements of the Provider's rulesets for the checked cluster.
+func (p *Provider) PreflightRequirements() []preflight.Requirement {
+	return sharedprovider.PreflightRequirements([]preflight.Requirement{{Cluster: "cluster", Config: p.Config}}, p.rulesets)
+}
+
 // Metadata returns the metadata of the Provider.
 func (p *Provider) Metadata() map[string]string {
 	if p.metadata == nil {
@@ -137,6 +155,10 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		return nil, err
 	}
 
+	if err := providerArgs.OpsPod.Validate(field.NewPath("args", "opsPod")).ToAggregate(); err != nil {
+		return nil, err
+	}
+
 	kubeconfig, err := kubeutils.RESTConfigFromFile(providerArgs.KubeconfigPath)
 	if err != nil {
 		return nil, err
@@ -146,6 +168,7 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		WithID(providerConf.ID),
 		WithName(providerConf.Name),
 		WithAdditionalOpsPodLabels(providerArgs.AdditionalOpsPodLabels),
+		WithOpsPod(providerArgs.OpsPod),
 		WithConfig(kubeconfig),
 		WithMetadata(providerConf.Metadata),
 	)
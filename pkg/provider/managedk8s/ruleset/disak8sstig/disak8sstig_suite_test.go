@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disak8sstig_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDISAK8sSTIG(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Managed K8s DISA Kubernetes STIG Ruleset Test Suite")
+}
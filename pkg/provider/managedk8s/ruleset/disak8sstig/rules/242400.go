@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"slices"
 	"strings"
-	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -40,12 +39,13 @@ var (
 )
 
 type Rule242400 struct {
-	InstanceID   string
-	Client       client.Client
-	V1RESTClient rest.Interface
-	PodContext   pod.PodContext
-	Options      *Options242400
-	Logger       provider.Logger
+	InstanceID      string
+	Client          client.Client
+	V1RESTClient    rest.Interface
+	PodContext      pod.PodContext
+	OpsPodNamespace string
+	Options         *Options242400
+	Logger          provider.Logger
 }
 
 type Options242400 struct {
@@ -172,40 +172,15 @@ func (r *Rule242400) checkKubeProxy(
 		checkResults            []rule.CheckResult
 		additionalLabels        = map[string]string{pod.LabelInstanceID: r.InstanceID}
 		podName                 = fmt.Sprintf("diki-%s-%s", r.ID(), sharedrules.Generator.Generate(10))
-		execPodTarget           = rule.NewTarget("name", podName, "namespace", "kube-system", "kind", "Pod")
 		kubeProxyContainerNames = []string{"kube-proxy", "proxy"}
 	)
 
-	defer func() {
-		timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-		defer cancel()
-
-		if err := r.PodContext.Delete(timeoutCtx, podName, "kube-system"); err != nil {
-			r.Logger.Error(err.Error())
-		}
-	}()
-
-	podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, "kube-system", imageName, nodeName, additionalLabels))
+	podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, r.OpsPodNamespace, imageName, nodeName, additionalLabels))
 	if err != nil {
-		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
-	}
-
-	execPod := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
-			Namespace: "kube-system",
-		},
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), rule.NewTarget("name", nodeName, "kind", "Node"))}
 	}
-
-	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(execPod), execPod); err != nil {
-		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
-	}
-
-	var (
-		execContainerID     = execPod.Status.ContainerStatuses[0].ContainerID
-		execBaseContainerID = strings.Split(execContainerID, "//")[1]
-		execContainerPath   = fmt.Sprintf("/run/containerd/io.containerd.runtime.v2.task/k8s.io/%s/rootfs", execBaseContainerID)
-	)
+	execPod := podExecutor.Pod()
+	execPodTarget := rule.NewTarget("name", execPod.Name, "namespace", execPod.Namespace, "kind", "Pod")
 
 	slices.SortFunc(pods, func(a, b corev1.Pod) int {
 		return cmp.Compare(a.Name, b.Name)
@@ -240,7 +215,7 @@ func (r *Rule242400) checkKubeProxy(
 				continue
 			}
 
-			kubeProxyMounts, err := intutils.GetContainerMounts(ctx, execContainerPath, podExecutor, kubeProxyContainerID)
+			kubeProxyMounts, err := intutils.GetContainerMounts(ctx, podExecutor, kubeProxyContainerID)
 			if err != nil {
 				checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
 				continue
@@ -216,10 +216,11 @@ var _ = Describe("#242400", func() {
 		executeReturnErrors := [][]error{{nil, nil, nil, nil, nil, nil}}
 		fakePodContext = fakepod.NewFakeSimplePodContext(executeReturnStrings, executeReturnErrors)
 		r := &rules.Rule242400{
-			InstanceID:   instanceID,
-			Client:       fakeClient,
-			PodContext:   fakePodContext,
-			V1RESTClient: fakeRESTClient,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
+			V1RESTClient:    fakeRESTClient,
 		}
 		ruleResult, err := r.Run(ctx)
 
@@ -285,11 +286,12 @@ var _ = Describe("#242400", func() {
 
 		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{{}}, [][]error{{}})
 		r := &rules.Rule242400{
-			InstanceID:   instanceID,
-			Client:       fakeClient,
-			PodContext:   fakePodContext,
-			V1RESTClient: fakeRESTClient,
-			Options:      &options,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
+			V1RESTClient:    fakeRESTClient,
+			Options:         &options,
 		}
 		ruleResult, err := r.Run(ctx)
 
@@ -346,11 +348,12 @@ var _ = Describe("#242400", func() {
 
 		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{{}}, [][]error{{}})
 		r := &rules.Rule242400{
-			Logger:       testLogger,
-			InstanceID:   instanceID,
-			Client:       fakeClient,
-			V1RESTClient: fakeRESTClient,
-			PodContext:   fakePodContext,
+			Logger:          testLogger,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			V1RESTClient:    fakeRESTClient,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -379,10 +382,11 @@ var _ = Describe("#242400", func() {
 			}),
 		}
 		r := &rules.Rule242400{
-			InstanceID:   instanceID,
-			Client:       fakeClient,
-			PodContext:   fakePodContext,
-			V1RESTClient: fakeRESTClient,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
+			V1RESTClient:    fakeRESTClient,
 		}
 		ruleResult, err := r.Run(ctx)
 
@@ -408,10 +412,11 @@ var _ = Describe("#242400", func() {
 			}),
 		}
 		r := &rules.Rule242400{
-			InstanceID:   instanceID,
-			Client:       fakeClient,
-			PodContext:   fakePodContext,
-			V1RESTClient: fakeRESTClient,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
+			V1RESTClient:    fakeRESTClient,
 			Options: &rules.Options242400{
 				KubeProxyOptions: option.KubeProxyOptions{
 					KubeProxyDisabled: true,
@@ -180,10 +180,11 @@ tlsCertFile: /var/lib/certs/tls.crt`
 		kubeProxySelector := labels.SelectorFromSet(labels.Set{"role": "proxy"})
 		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{}, [][]error{})
 		r := &rules.Rule242451{
-			Logger:     testLogger,
-			InstanceID: instanceID,
-			Client:     fakeClient,
-			PodContext: fakePodContext,
+			Logger:          testLogger,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -205,11 +206,12 @@ tlsCertFile: /var/lib/certs/tls.crt`
 
 			fakePodContext = fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 			r := &rules.Rule242451{
-				Logger:     testLogger,
-				InstanceID: instanceID,
-				Client:     fakeClient,
-				PodContext: fakePodContext,
-				Options:    &options,
+				Logger:          testLogger,
+				InstanceID:      instanceID,
+				Client:          fakeClient,
+				PodContext:      fakePodContext,
+				OpsPodNamespace: "kube-system",
+				Options:         &options,
 			}
 
 			ruleResult, err := r.Run(ctx)
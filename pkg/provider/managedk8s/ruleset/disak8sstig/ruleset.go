@@ -9,15 +9,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
 	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/retry"
 	"github.com/gardener/diki/pkg/ruleset"
 	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+	shareddisak8sstig "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig"
 )
 
 const (
@@ -39,16 +45,29 @@ type Ruleset struct {
 	version                string
 	rules                  map[string]rule.Rule
 	AdditionalOpsPodLabels map[string]string
+	OpsPod                 *pod.OpsPodConfig
 	Config                 *rest.Config
+	OpsPodContext          *pod.LimitedPodContext
 	numWorkers             int
 	args                   Args
 	instanceID             string
+	podContexts            []*pod.PooledPodContext
+	ruleTimeouts           sharedruleset.RuleTimeouts
+	exceptions             []config.ExceptionConfig
 	logger                 *slog.Logger
 }
 
 // Args are Ruleset specific arguments.
 type Args struct {
 	MaxRetries *int `json:"maxRetries" yaml:"maxRetries"`
+	// RetryBaseWait is the wait before the first three retries of a rule run. It is doubled for every following retry.
+	RetryBaseWait *metav1.Duration `json:"retryBaseWait" yaml:"retryBaseWait"`
+	// RetryMaxWait is the max wait before a retry of a rule run.
+	RetryMaxWait *metav1.Duration `json:"retryMaxWait" yaml:"retryMaxWait"`
+	// RetryJitter is the max fraction of a wait that is randomly added to it.
+	RetryJitter *float64 `json:"retryJitter" yaml:"retryJitter"`
+	// RuleTimeout is the max duration of a single rule run. It can be overwritten per rule.
+	RuleTimeout *metav1.Duration `json:"ruleTimeout" yaml:"ruleTimeout"`
 }
 
 // New creates a new Ruleset.
@@ -85,7 +104,7 @@ func (r *Ruleset) Version() string {
 }
 
 // FromGenericConfig creates a Ruleset from a RulesetConfig
-func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabels map[string]string, managedConfig *rest.Config) (*Ruleset, error) {
+func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabels map[string]string, opsPod *pod.OpsPodConfig, managedConfig *rest.Config, opsPodContext *pod.LimitedPodContext) (*Ruleset, error) {
 	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
 	if err != nil {
 		return nil, err
@@ -99,7 +118,9 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabel
 	ruleset, err := New(
 		WithVersion(rulesetConfig.Version),
 		WithAdditionalOpsPodLabels(additionalOpsPodLabels),
+		WithOpsPod(opsPod),
 		WithConfig(managedConfig),
+		WithOpsPodContext(opsPodContext),
 		WithArgs(rulesetArgs),
 	)
 	if err != nil {
@@ -115,6 +136,19 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabel
 		ruleOptions[opt.RuleID] = opt
 	}
 
+	ruleTimeouts, err := sharedruleset.NewRuleTimeouts(rulesetArgs.RuleTimeout, ruleOptions)
+	if err != nil {
+		return nil, err
+	}
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
+	if err := sharedruleset.ValidateExceptions(rulesetConfig.Exceptions); err != nil {
+		return nil, fmt.Errorf("invalid exceptions for ruleset with id %s and version %s: %w", rulesetConfig.ID, rulesetConfig.Version, err)
+	}
+	setExceptions := WithExceptions(rulesetConfig.Exceptions)
+	setExceptions(ruleset)
+
 	switch rulesetConfig.Version {
 	case "v2r2":
 		if err := ruleset.registerV2R2Rules(ruleOptions); err != nil {
@@ -128,6 +162,13 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabel
 		return nil, fmt.Errorf("unknown ruleset %s version: %s", rulesetConfig.ID, rulesetConfig.Version)
 	}
 
+	pluginRules, err := sharedruleset.PluginRules(rulesetConfig.Plugins, managedConfig, ruleOptions, ruleset.Logger())
+	if err != nil {
+		return nil, err
+	}
+	if err := ruleset.AddRules(pluginRules...); err != nil {
+		return nil, err
+	}
 	return ruleset, nil
 }
 
@@ -138,12 +179,25 @@ func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, erro
 		return rule.RuleResult{}, fmt.Errorf("rule with id %s is not registered in the ruleset", id)
 	}
 
-	return rr.Run(ctx)
+	defer sharedruleset.ClosePodContexts(ctx, r.podContexts, r.Logger())
+
+	res, err := sharedruleset.RunRule(ctx, rr, r.ruleTimeouts.Timeout(id), r.Logger())
+	if err != nil {
+		res = sharedruleset.ErroredRuleResult(rr, err)
+	}
+	shareddisak8sstig.Documentation.Document(&res)
+	sharedruleset.ApplyRuleExceptions(&res, r.exceptions, time.Now())
+	return res, err
 }
 
 // Run executes all known Rules of the Ruleset.
 func (r *Ruleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
-	return sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.Logger())
+	defer sharedruleset.ClosePodContexts(ctx, r.podContexts, r.Logger())
+
+	res, err := sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.ruleTimeouts, r.Logger())
+	shareddisak8sstig.Documentation.DocumentRuleset(&res)
+	sharedruleset.ApplyExceptions(&res, r.exceptions, time.Now())
+	return res, err
 }
 
 // AddRules adds Rules to the Ruleset.
@@ -157,6 +211,42 @@ func (r *Ruleset) AddRules(rules ...rule.Rule) error {
 	return nil
 }
 
+// newPodContext creates a pod context that shares one ops pod per node between the rules of the Ruleset.
+// The ops pods are limited by the given ops pod context of the cluster, which is shared with the other rulesets
+// of the provider, or by a new unlimited one if none is given. The ops pods are deleted at the end of every run.
+func (r *Ruleset) newPodContext(opsPodContext *pod.LimitedPodContext, c client.Client, config *rest.Config) (*pod.PooledPodContext, error) {
+	if opsPodContext == nil {
+		var err error
+		if opsPodContext, err = pod.NewOpsPodContext(c, config, r.AdditionalOpsPodLabels, r.OpsPod, r.Logger()); err != nil {
+			return nil, err
+		}
+	}
+
+	podContext, err := pod.NewPooledPodContext(opsPodContext)
+	if err != nil {
+		return nil, err
+	}
+	// idle ops pods are deleted when other ops pods wait for capacity
+	opsPodContext.AddReclaimer(podContext)
+	r.podContexts = append(r.podContexts, podContext)
+	return podContext, nil
+}
+
+// retryBackoff returns the backoff of retryable rules configured by the Ruleset args.
+func (r *Ruleset) retryBackoff() retry.Backoff {
+	backoff := retry.DefaultBackoff()
+	if r.args.RetryBaseWait != nil {
+		backoff.Base = r.args.RetryBaseWait.Duration
+	}
+	if r.args.RetryMaxWait != nil {
+		backoff.Cap = r.args.RetryMaxWait.Duration
+	}
+	if r.args.RetryJitter != nil {
+		backoff.Jitter = *r.args.RetryJitter
+	}
+	return backoff
+}
+
 // Logger returns the Ruleset's logger.
 // If not set it set it to slog.Default().With("ruleset", r.ID(), "version", r.Version() then return it.
 func (r *Ruleset) Logger() *slog.Logger {
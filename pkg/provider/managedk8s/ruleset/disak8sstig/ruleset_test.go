@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disak8sstig_test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	certutil "k8s.io/client-go/util/cert"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("ruleset", func() {
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		logger        *slog.Logger
+		managedConfig *rest.Config
+		opsPodContext *pod.LimitedPodContext
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+		logger = slog.New(slog.DiscardHandler)
+
+		caData, _, err := certutil.GenerateSelfSignedCertKey("localhost", nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		// the rules are run against an unreachable cluster and return errored results
+		managedConfig = &rest.Config{Host: "https://127.0.0.1:1", TLSClientConfig: rest.TLSClientConfig{CAData: caData}}
+
+		c, err := client.New(managedConfig, client.Options{})
+		Expect(err).ToNot(HaveOccurred())
+		opsPodContext, err = pod.NewOpsPodContext(c, managedConfig, nil, nil, logger)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("should document every registered rule with a STIG Vuln ID, CCI and NIST SP 800-53 reference", func() {
+		for _, version := range disak8sstig.SupportedVersions {
+			r, err := disak8sstig.FromGenericConfig(config.RulesetConfig{ID: disak8sstig.RulesetID, Version: version}, nil, nil, managedConfig, opsPodContext)
+			Expect(err).ToNot(HaveOccurred())
+			setLogger := disak8sstig.WithLogger(logger)
+			setLogger(r)
+
+			res, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RuleResults).ToNot(BeEmpty())
+			for _, ruleResult := range res.RuleResults {
+				Expect(ruleResult.Documentation).ToNot(BeNil(), "rule %s of version %s is not documented", ruleResult.RuleID, version)
+				var referenceTypes []rule.ReferenceType
+				for _, reference := range ruleResult.Documentation.References {
+					referenceTypes = append(referenceTypes, reference.Type)
+				}
+				Expect(referenceTypes).To(ContainElements(rule.ReferenceSTIGVulnID, rule.ReferenceCCI, rule.ReferenceNIST80053), "rule %s of version %s", ruleResult.RuleID, version)
+			}
+		}
+	})
+})
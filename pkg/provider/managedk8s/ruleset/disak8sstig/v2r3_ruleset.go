@@ -15,10 +15,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
-	"github.com/gardener/diki/pkg/kubernetes/pod"
 	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig/rules"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/rule/retry"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/retryerrors"
 	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
@@ -30,11 +30,13 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		return err
 	}
 
-	podContext, err := pod.NewSimplePodContext(client, r.Config, r.AdditionalOpsPodLabels)
+	podContext, err := r.newPodContext(r.OpsPodContext, client, r.Config)
 	if err != nil {
 		return err
 	}
 
+	opsPodNamespace := r.OpsPod.PodNamespace("kube-system")
+
 	clientSet, err := kubernetes.NewForConfig(r.Config)
 	if err != nil {
 		return err
@@ -139,7 +141,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		*retryerrors.ContainerFileNotFoundOnNodeRegexp,
 		*retryerrors.ContainerNotReadyRegexp,
 		*retryerrors.OpsPodNotFoundRegexp,
-		*retryerrors.ObjectNotFoundRegexp,
+		*retryerrors.ContainerNotFoundByRuntimeRegexp,
 	)
 
 	const (
@@ -260,39 +262,45 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242393)),
 			retry.WithBaseRule(&sharedrules.Rule242393{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242393),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242393,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242393),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242393,
 			}),
 			retry.WithRetryCondition(rcOpsPod),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242394)),
 			retry.WithBaseRule(&sharedrules.Rule242394{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242394),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242394,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242394),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242394,
 			}),
 			retry.WithRetryCondition(rcOpsPod),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		&sharedrules.Rule242395{Client: client},
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242396)),
 			retry.WithBaseRule(&sharedrules.Rule242396{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242396),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242396,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242396),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242396,
 			}),
 			retry.WithRetryCondition(rcOpsPod),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		&sharedrules.Rule242397{
 			Client:       client,
@@ -317,15 +325,17 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242400)),
 			retry.WithBaseRule(&rules.Rule242400{
-				Logger:       r.Logger().With("rule_id", sharedrules.ID242400),
-				InstanceID:   r.instanceID,
-				Client:       client,
-				PodContext:   podContext,
-				V1RESTClient: clientSet.CoreV1().RESTClient(),
-				Options:      opts242400,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242400),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				V1RESTClient:    clientSet.CoreV1().RESTClient(),
+				Options:         opts242400,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 
 		rule.NewSkipRule(
@@ -345,14 +355,16 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242404)),
 			retry.WithBaseRule(&sharedrules.Rule242404{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242404),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242404,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242404),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242404,
 			}),
 			retry.WithRetryCondition(rcOpsPod),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID242405,
@@ -364,26 +376,30 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242406)),
 			retry.WithBaseRule(&sharedrules.Rule242406{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242406),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242406,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242406),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242406,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242407)),
 			retry.WithBaseRule(&sharedrules.Rule242407{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242407),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242407,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242407),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242407,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID242408,
@@ -603,86 +619,100 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242447)),
 			retry.WithBaseRule(&sharedrules.Rule242447{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242447),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242447,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242447),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242447,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242448)),
 			retry.WithBaseRule(&sharedrules.Rule242448{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242448),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242448,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242448),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242448,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242449)),
 			retry.WithBaseRule(&sharedrules.Rule242449{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242449),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242449,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242449),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242449,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242450)),
 			retry.WithBaseRule(&sharedrules.Rule242450{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242450),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242450,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242450),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242450,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242451)),
 			retry.WithBaseRule(&rules.Rule242451{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242451),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242451,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242451),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242451,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242452)),
 			retry.WithBaseRule(&sharedrules.Rule242452{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242452),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242452,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242452),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242452,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242453)),
 			retry.WithBaseRule(&sharedrules.Rule242453{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242453),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242453,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242453),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242453,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID242454,
@@ -764,26 +794,30 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242466)),
 			retry.WithBaseRule(&rules.Rule242466{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242466),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242466,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242466),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242466,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242467)),
 			retry.WithBaseRule(&rules.Rule242467{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242467),
-				InstanceID: r.instanceID,
-				Client:     client,
-				PodContext: podContext,
-				Options:    opts242467,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242467),
+				InstanceID:      r.instanceID,
+				Client:          client,
+				PodContext:      podContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242467,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		&sharedrules.Rule245541{
 			Client:       client,
@@ -827,6 +861,11 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 	}
 
+	var (
+		maxRetries   = *r.args.MaxRetries
+		retryBackoff = r.retryBackoff()
+		logger       = r.Logger()
+	)
 	for i, r := range rules {
 		var severityLevel rule.SeverityLevel
 		if severity, ok := r.(rule.Severity); !ok {
@@ -836,8 +875,15 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		}
 
 		opt, found := ruleOptions[r.ID()]
-		if found && opt.Skip != nil && opt.Skip.Enabled {
+		switch {
+		case found && opt.Skip != nil && opt.Skip.Enabled:
 			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+		case found && len(opt.RetryPatterns) > 0:
+			retryableRule, err := sharedruleset.WithRetryPatterns(r, opt.RetryPatterns, retry.WithMaxRetries(maxRetries), retry.WithBackoff(retryBackoff), retry.WithLogger(logger.With("rule_id", r.ID())))
+			if err != nil {
+				return err
+			}
+			rules[i] = retryableRule
 		}
 	}
 
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package securityhardenedk8s
+
+import (
+	_ "embed"
+
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+//go:embed documentation.yaml
+var documentationYAML []byte
+
+// documentation contains the documentation of the security hardened Kubernetes cluster rules by rule ID.
+var documentation = sharedruleset.MustParseRuleDocumentation(documentationYAML)
@@ -8,6 +8,9 @@ import (
 	"log/slog"
 
 	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
 )
 
 // CreateOption is a function that acts on a [Ruleset]
@@ -38,6 +41,20 @@ func WithNumberOfWorkers(numWorkers int) CreateOption {
 	}
 }
 
+// WithRuleTimeouts sets the rule timeouts of a [Ruleset].
+func WithRuleTimeouts(ruleTimeouts sharedruleset.RuleTimeouts) CreateOption {
+	return func(r *Ruleset) {
+		r.ruleTimeouts = ruleTimeouts
+	}
+}
+
+// WithExceptions sets the exceptions of a [Ruleset].
+func WithExceptions(exceptions []config.ExceptionConfig) CreateOption {
+	return func(r *Ruleset) {
+		r.exceptions = exceptions
+	}
+}
+
 // WithLogger the logger of a [Ruleset].
 func WithLogger(logger *slog.Logger) CreateOption {
 	return func(r *Ruleset) {
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package securityhardenedk8s
+
+import (
+	authorizationv1 "k8s.io/api/authorization/v1"
+
+	"github.com/gardener/diki/pkg/preflight"
+)
+
+var _ preflight.Ruleset = &Ruleset{}
+
+// PreflightRequirements returns the requirements of the Ruleset for the managed cluster.
+func (r *Ruleset) PreflightRequirements() []preflight.Requirement {
+	return []preflight.Requirement{
+		{
+			Cluster: "cluster",
+			Config:  r.Config,
+			Permissions: []authorizationv1.ResourceAttributes{
+				{Verb: "list", Resource: "namespaces"},
+				{Verb: "list", Resource: "pods"},
+				{Verb: "list", Resource: "services"},
+				{Verb: "list", Group: "apps", Resource: "replicasets"},
+				{Verb: "list", Group: "networking.k8s.io", Resource: "networkpolicies"},
+				{Verb: "list", Group: "rbac.authorization.k8s.io", Resource: "roles"},
+				{Verb: "list", Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+				{Verb: "list", Group: "storage.k8s.io", Resource: "storageclasses"},
+			},
+		},
+	}
+}
@@ -6,9 +6,12 @@ package securityhardenedk8s
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 
 	"github.com/gardener/diki/pkg/config"
@@ -33,11 +36,19 @@ var (
 
 // Ruleset implements Security Hardened Kubernetes Cluster.
 type Ruleset struct {
-	version    string
-	rules      map[string]rule.Rule
-	Config     *rest.Config
-	numWorkers int
-	logger     *slog.Logger
+	version      string
+	rules        map[string]rule.Rule
+	Config       *rest.Config
+	numWorkers   int
+	ruleTimeouts sharedruleset.RuleTimeouts
+	exceptions   []config.ExceptionConfig
+	logger       *slog.Logger
+}
+
+// Args are Ruleset specific arguments.
+type Args struct {
+	// RuleTimeout is the max duration of a single rule run. It can be overwritten per rule.
+	RuleTimeout *metav1.Duration `json:"ruleTimeout" yaml:"ruleTimeout"`
 }
 
 // New creates a new Ruleset.
@@ -71,6 +82,16 @@ func (r *Ruleset) Version() string {
 
 // FromGenericConfig creates a Ruleset from a RulesetConfig
 func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.Config) (*Ruleset, error) {
+	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var rulesetArgs Args
+	if err := json.Unmarshal(rulesetArgsByte, &rulesetArgs); err != nil {
+		return nil, err
+	}
+
 	ruleset, err := New(
 		WithVersion(rulesetConfig.Version),
 		WithConfig(managedConfig),
@@ -88,6 +109,19 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.C
 		ruleOptions[opt.RuleID] = opt
 	}
 
+	ruleTimeouts, err := sharedruleset.NewRuleTimeouts(rulesetArgs.RuleTimeout, ruleOptions)
+	if err != nil {
+		return nil, err
+	}
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
+	if err := sharedruleset.ValidateExceptions(rulesetConfig.Exceptions); err != nil {
+		return nil, fmt.Errorf("invalid exceptions for ruleset with id %s and version %s: %w", rulesetConfig.ID, rulesetConfig.Version, err)
+	}
+	setExceptions := WithExceptions(rulesetConfig.Exceptions)
+	setExceptions(ruleset)
+
 	switch rulesetConfig.Version {
 	case "v0.1.0":
 		if err := ruleset.registerV01Rules(ruleOptions); err != nil {
@@ -97,6 +131,13 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.C
 		return nil, fmt.Errorf("unknown ruleset %s version: %s", rulesetConfig.ID, rulesetConfig.Version)
 	}
 
+	pluginRules, err := sharedruleset.PluginRules(rulesetConfig.Plugins, managedConfig, ruleOptions, ruleset.Logger())
+	if err != nil {
+		return nil, err
+	}
+	if err := ruleset.AddRules(pluginRules...); err != nil {
+		return nil, err
+	}
 	return ruleset, nil
 }
 
@@ -107,12 +148,21 @@ func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, erro
 		return rule.RuleResult{}, fmt.Errorf("rule with id %s is not registered in the ruleset", id)
 	}
 
-	return rr.Run(ctx)
+	res, err := sharedruleset.RunRule(ctx, rr, r.ruleTimeouts.Timeout(id), r.Logger())
+	if err != nil {
+		res = sharedruleset.ErroredRuleResult(rr, err)
+	}
+	documentation.Document(&res)
+	sharedruleset.ApplyRuleExceptions(&res, r.exceptions, time.Now())
+	return res, err
 }
 
 // Run executes all known Rules of the Ruleset.
 func (r *Ruleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
-	return sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.Logger())
+	res, err := sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.ruleTimeouts, r.Logger())
+	documentation.DocumentRuleset(&res)
+	sharedruleset.ApplyExceptions(&res, r.exceptions, time.Now())
+	return res, err
 }
 
 // AddRules adds Rules to the Ruleset.
@@ -7,8 +7,11 @@ package provider
 import (
 	"context"
 
+	"k8s.io/client-go/rest"
+
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/preflight"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
 )
@@ -23,6 +26,28 @@ type Provider interface {
 	RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, error)
 }
 
+// ClusterProvider is a Provider that checks a Kubernetes cluster.
+type ClusterProvider interface {
+	Provider
+	// RESTConfig returns the config of the checked cluster.
+	RESTConfig() *rest.Config
+}
+
+// MultiClusterProvider is a ClusterProvider that checks more than one cluster.
+// The check targets of the cluster returned by RESTConfig contain a "cluster" attribute.
+type MultiClusterProvider interface {
+	ClusterProvider
+	// RESTConfigCluster returns the value of the "cluster" target attribute of the cluster returned by RESTConfig.
+	RESTConfigCluster() string
+}
+
+// PreflightProvider is a Provider that can describe what its rulesets need from the checked clusters.
+type PreflightProvider interface {
+	Provider
+	// PreflightRequirements returns the requirements of the provider's rulesets.
+	PreflightRequirements() []preflight.Requirement
+}
+
 // ProviderResult is the result of a provider run.
 type ProviderResult struct {
 	ProviderID     string
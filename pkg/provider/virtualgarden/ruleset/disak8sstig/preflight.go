@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disak8sstig
+
+import (
+	authorizationv1 "k8s.io/api/authorization/v1"
+
+	"github.com/gardener/diki/pkg/preflight"
+)
+
+var _ preflight.Ruleset = &Ruleset{}
+
+// PreflightRequirements returns the requirements of the Ruleset for the runtime cluster.
+func (r *Ruleset) PreflightRequirements() []preflight.Requirement {
+	const ns = "garden"
+	return []preflight.Requirement{
+		{
+			Cluster: "runtime",
+			Config:  r.RuntimeConfig,
+			Permissions: []authorizationv1.ResourceAttributes{
+				{Namespace: ns, Verb: "list", Resource: "pods"},
+				{Namespace: ns, Verb: "get", Resource: "configmaps"},
+				{Namespace: ns, Verb: "get", Resource: "secrets"},
+				{Namespace: ns, Verb: "get", Group: "apps", Resource: "deployments"},
+				{Namespace: ns, Verb: "get", Group: "apps", Resource: "statefulsets"},
+			},
+			OpsPod: &preflight.OpsPodRequirement{Namespace: r.OpsPod.PodNamespace("kube-system"), AdditionalLabels: r.AdditionalOpsPodLabels, Config: r.OpsPod, InstanceIDs: []string{r.instanceID}},
+		},
+	}
+}
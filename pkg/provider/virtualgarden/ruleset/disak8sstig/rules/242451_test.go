@@ -279,11 +279,12 @@ var _ = Describe("#242451", func() {
 		eventsSelector := labels.SelectorFromSet(labels.Set{"app.kubernetes.io/part-of": "virtual-garden-etcd-events"})
 		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{}, [][]error{})
 		r := &rules.Rule242451{
-			Logger:     testLogger,
-			InstanceID: instanceID,
-			Client:     fakeClient,
-			Namespace:  Namespace,
-			PodContext: fakePodContext,
+			Logger:          testLogger,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			Namespace:       Namespace,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -307,12 +308,13 @@ var _ = Describe("#242451", func() {
 
 			fakePodContext = fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 			r := &rules.Rule242451{
-				Logger:     testLogger,
-				InstanceID: instanceID,
-				Client:     fakeClient,
-				Namespace:  Namespace,
-				PodContext: fakePodContext,
-				Options:    options,
+				Logger:          testLogger,
+				InstanceID:      instanceID,
+				Client:          fakeClient,
+				Namespace:       Namespace,
+				PodContext:      fakePodContext,
+				OpsPodNamespace: "kube-system",
+				Options:         options,
 			}
 
 			ruleResult, err := r.Run(ctx)
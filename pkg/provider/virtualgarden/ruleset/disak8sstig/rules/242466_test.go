@@ -270,11 +270,12 @@ var _ = Describe("#242466", func() {
 		eventsSelector := labels.SelectorFromSet(labels.Set{"app.kubernetes.io/part-of": "virtual-garden-etcd-events"})
 		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{}, [][]error{})
 		r := &rules.Rule242466{
-			Logger:     testLogger,
-			InstanceID: instanceID,
-			Client:     fakeClient,
-			Namespace:  Namespace,
-			PodContext: fakePodContext,
+			Logger:          testLogger,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			Namespace:       Namespace,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -298,11 +299,12 @@ var _ = Describe("#242466", func() {
 
 			fakePodContext = fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 			r := &rules.Rule242466{
-				Logger:     testLogger,
-				InstanceID: instanceID,
-				Client:     fakeClient,
-				Namespace:  Namespace,
-				PodContext: fakePodContext,
+				Logger:          testLogger,
+				InstanceID:      instanceID,
+				Client:          fakeClient,
+				Namespace:       Namespace,
+				PodContext:      fakePodContext,
+				OpsPodNamespace: "kube-system",
 			}
 
 			ruleResult, err := r.Run(ctx)
@@ -276,11 +276,12 @@ var _ = Describe("#242467", func() {
 		eventsSelector := labels.SelectorFromSet(labels.Set{"app.kubernetes.io/part-of": "virtual-garden-etcd-events"})
 		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{}, [][]error{})
 		r := &rules.Rule242467{
-			Logger:     testLogger,
-			InstanceID: instanceID,
-			Client:     fakeClient,
-			Namespace:  Namespace,
-			PodContext: fakePodContext,
+			Logger:          testLogger,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			Namespace:       Namespace,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -304,11 +305,12 @@ var _ = Describe("#242467", func() {
 
 			fakePodContext = fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 			r := &rules.Rule242467{
-				Logger:     testLogger,
-				InstanceID: instanceID,
-				Client:     fakeClient,
-				Namespace:  Namespace,
-				PodContext: fakePodContext,
+				Logger:          testLogger,
+				InstanceID:      instanceID,
+				Client:          fakeClient,
+				Namespace:       Namespace,
+				PodContext:      fakePodContext,
+				OpsPodNamespace: "kube-system",
 			}
 
 			ruleResult, err := r.Run(ctx)
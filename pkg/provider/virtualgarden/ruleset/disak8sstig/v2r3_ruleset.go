@@ -12,10 +12,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
-	"github.com/gardener/diki/pkg/kubernetes/pod"
 	"github.com/gardener/diki/pkg/provider/virtualgarden/ruleset/disak8sstig/rules"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/rule/retry"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/retryerrors"
 	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
@@ -27,10 +27,12 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		return err
 	}
 
-	runtimePodContext, err := pod.NewSimplePodContext(runtimeClient, r.RuntimeConfig, r.AdditionalOpsPodLabels)
+	runtimePodContext, err := r.newPodContext(r.RuntimeOpsPodContext, runtimeClient, r.RuntimeConfig)
 	if err != nil {
 		return err
 	}
+
+	opsPodNamespace := r.OpsPod.PodNamespace("kube-system")
 	opts242445, err := getV2R3OptionOrNil[option.FileOwnerOptions](ruleOptions[sharedrules.ID242445].Args)
 	if err != nil {
 		return fmt.Errorf("rule option 242445 error: %s", err.Error())
@@ -53,7 +55,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		*retryerrors.ContainerFileNotFoundOnNodeRegexp,
 		*retryerrors.ContainerNotReadyRegexp,
 		*retryerrors.OpsPodNotFoundRegexp,
-		*retryerrors.ObjectNotFoundRegexp,
+		*retryerrors.ContainerNotFoundByRuntimeRegexp,
 	)
 
 	const (
@@ -485,12 +487,14 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				Client:             runtimeClient,
 				Namespace:          ns,
 				PodContext:         runtimePodContext,
+				OpsPodNamespace:    opsPodNamespace,
 				ETCDMainSelector:   labels.SelectorFromSet(labels.Set{"app.kubernetes.io/part-of": etcdMain}),
 				ETCDEventsSelector: labels.SelectorFromSet(labels.Set{"app.kubernetes.io/part-of": etcdEvents}),
 				Options:            opts242445,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242446)),
@@ -500,11 +504,13 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				Client:          runtimeClient,
 				Namespace:       ns,
 				PodContext:      runtimePodContext,
+				OpsPodNamespace: opsPodNamespace,
 				DeploymentNames: []string{apiserverDeploymentName, kcmDeploymentName},
 				Options:         opts242446,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID242447,
@@ -537,15 +543,17 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242451)),
 			retry.WithBaseRule(&rules.Rule242451{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242451),
-				InstanceID: r.instanceID,
-				Client:     runtimeClient,
-				Namespace:  ns,
-				PodContext: runtimePodContext,
-				Options:    opts242451,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242451),
+				InstanceID:      r.instanceID,
+				Client:          runtimeClient,
+				Namespace:       ns,
+				PodContext:      runtimePodContext,
+				OpsPodNamespace: opsPodNamespace,
+				Options:         opts242451,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID242452,
@@ -597,11 +605,13 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				Client:             runtimeClient,
 				Namespace:          ns,
 				PodContext:         runtimePodContext,
+				OpsPodNamespace:    opsPodNamespace,
 				ETCDMainSelector:   labels.SelectorFromSet(labels.Set{"app.kubernetes.io/part-of": etcdMain}),
 				ETCDEventsSelector: labels.SelectorFromSet(labels.Set{"app.kubernetes.io/part-of": etcdEvents}),
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242460)),
@@ -611,10 +621,12 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				Client:          runtimeClient,
 				Namespace:       ns,
 				PodContext:      runtimePodContext,
+				OpsPodNamespace: opsPodNamespace,
 				DeploymentNames: []string{apiserverDeploymentName, kcmDeploymentName},
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		&sharedrules.Rule242461{
 			Client:         runtimeClient,
@@ -650,26 +662,30 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242466)),
 			retry.WithBaseRule(&rules.Rule242466{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242466),
-				InstanceID: r.instanceID,
-				Client:     runtimeClient,
-				Namespace:  ns,
-				PodContext: runtimePodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242466),
+				InstanceID:      r.instanceID,
+				Client:          runtimeClient,
+				Namespace:       ns,
+				PodContext:      runtimePodContext,
+				OpsPodNamespace: opsPodNamespace,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242467)),
 			retry.WithBaseRule(&rules.Rule242467{
-				Logger:     r.Logger().With("rule_id", sharedrules.ID242467),
-				InstanceID: r.instanceID,
-				Client:     runtimeClient,
-				Namespace:  ns,
-				PodContext: runtimePodContext,
+				Logger:          r.Logger().With("rule_id", sharedrules.ID242467),
+				InstanceID:      r.instanceID,
+				Client:          runtimeClient,
+				Namespace:       ns,
+				PodContext:      runtimePodContext,
+				OpsPodNamespace: opsPodNamespace,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
 			retry.WithMaxRetries(*r.args.MaxRetries),
+			retry.WithBackoff(r.retryBackoff()),
 		),
 		rule.NewSkipRule(
 			sharedrules.ID245541,
@@ -714,6 +730,11 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 	}
 
+	var (
+		maxRetries   = *r.args.MaxRetries
+		retryBackoff = r.retryBackoff()
+		logger       = r.Logger()
+	)
 	for i, r := range rules {
 		var severityLevel rule.SeverityLevel
 		if severity, ok := r.(rule.Severity); !ok {
@@ -723,8 +744,15 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		}
 
 		opt, found := ruleOptions[r.ID()]
-		if found && opt.Skip != nil && opt.Skip.Enabled {
+		switch {
+		case found && opt.Skip != nil && opt.Skip.Enabled:
 			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+		case found && len(opt.RetryPatterns) > 0:
+			retryableRule, err := sharedruleset.WithRetryPatterns(r, opt.RetryPatterns, retry.WithMaxRetries(maxRetries), retry.WithBackoff(retryBackoff), retry.WithLogger(logger.With("rule_id", r.ID())))
+			if err != nil {
+				return err
+			}
+			rules[i] = retryableRule
 		}
 	}
 
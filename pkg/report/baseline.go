@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// Baseline contains information about the baseline report that a report was compared to.
+type Baseline struct {
+	Time        time.Time `json:"time"`
+	DikiVersion string    `json:"dikiVersion"`
+}
+
+// ApplyBaseline compares the report to a baseline report and marks all check targets that
+// are already contained in the baseline as baseline findings. A check target is contained in
+// the baseline if the baseline reports the same target as a finding for the same provider,
+// ruleset and rule, regardless of the exact status of the finding. Targets with status Warning,
+// Failed, Errored or Not Implemented are findings. Checks with both baseline and new targets are split.
+func (r *Report) ApplyBaseline(baseline *Report) {
+	baselineKeys := map[string]struct{}{}
+	for _, provider := range baseline.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, rule := range ruleset.Rules {
+				for _, check := range rule.Checks {
+					if !isFinding(check.Status) {
+						continue
+					}
+					for _, target := range checkTargets(check) {
+						baselineKeys[baselineKey(provider.ID, ruleset.ID, ruleset.Version, rule.ID, target)] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	r.Baseline = &Baseline{
+		Time:        baseline.Time,
+		DikiVersion: baseline.DikiVersion,
+	}
+	for _, provider := range r.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for ruleIdx, rule := range ruleset.Rules {
+				var (
+					checks     = make([]Check, 0, len(rule.Checks))
+					checkIdxes = map[string]int{}
+				)
+				for _, check := range rule.Checks {
+					for _, target := range checkTargets(check) {
+						_, isBaseline := baselineKeys[baselineKey(provider.ID, ruleset.ID, ruleset.Version, rule.ID, target)]
+						// checks of a report to which a baseline was already applied are merged again
+						key := strings.Join([]string{string(check.Status), check.Message, strconv.FormatBool(isBaseline)}, "--")
+						idx, ok := checkIdxes[key]
+						if !ok {
+							checks = append(checks, Check{Status: check.Status, Message: check.Message, Baseline: isBaseline})
+							idx = len(checks) - 1
+							checkIdxes[key] = idx
+						}
+						if len(target) > 0 {
+							checks[idx].Targets = append(checks[idx].Targets, target)
+						}
+					}
+				}
+				ruleset.Rules[ruleIdx].Checks = checks
+			}
+		}
+	}
+	r.SetFingerprints()
+}
+
+// checkTargets returns the targets of a check or a single nil target for checks without targets.
+func checkTargets(check Check) []rule.Target {
+	if len(check.Targets) == 0 {
+		return []rule.Target{nil}
+	}
+	return check.Targets
+}
+
+// isFinding returns whether a check target with the given status is a finding for the baseline.
+func isFinding(status rule.Status) bool {
+	return !status.Less(rule.Warning)
+}
+
+func baselineKey(providerID, rulesetID, rulesetVersion, ruleID string, target rule.Target) string {
+	return strings.Join([]string{providerID, rulesetID, rulesetVersion, ruleID, canonicalTarget(target)}, "\x00")
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("baseline", func() {
+	var (
+		newReport = func(checks ...report.Check) *report.Report {
+			return &report.Report{
+				Time:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				DikiVersion: "1",
+				Providers: []report.Provider{
+					{
+						ID: "provider-foo",
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{ID: "1", Checks: checks},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+		baseline *report.Report
+	)
+
+	BeforeEach(func() {
+		baseline = newReport(
+			report.Check{Status: rule.Failed, Message: "old message", Targets: []rule.Target{rule.NewTarget("name", "foo"), rule.NewTarget("name", "bar")}},
+			report.Check{Status: rule.Errored, Message: "errored"},
+			report.Check{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "baz")}},
+		)
+	})
+
+	Describe("#ApplyBaseline", func() {
+		It("should split checks into new and baseline checks", func() {
+			rep := newReport(
+				report.Check{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "baz"), rule.NewTarget("name", "foo")}},
+				report.Check{Status: rule.Errored, Message: "errored"},
+				report.Check{Status: rule.Warning, Message: "warning", Targets: []rule.Target{rule.NewTarget("name", "bar")}},
+			)
+			rep.ApplyBaseline(baseline)
+
+			Expect(rep.Baseline).To(Equal(&report.Baseline{Time: baseline.Time, DikiVersion: "1"}))
+
+			checks := rep.Providers[0].Rulesets[0].Rules[0].Checks
+			Expect(checks).To(HaveLen(4))
+			Expect(checks[0].Baseline).To(BeFalse())
+			Expect(checks[0].Targets).To(Equal([]rule.Target{rule.NewTarget("name", "baz")}))
+			Expect(checks[0].Fingerprints).To(Equal([]string{report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "", rule.NewTarget("name", "baz"))}))
+			Expect(checks[1].Baseline).To(BeTrue())
+			Expect(checks[1].Targets).To(Equal([]rule.Target{rule.NewTarget("name", "foo")}))
+			Expect(checks[1].Fingerprints).To(HaveLen(1))
+			Expect(checks[2].Baseline).To(BeTrue())
+			Expect(checks[2].Status).To(Equal(rule.Errored))
+			Expect(checks[2].Targets).To(BeEmpty())
+			Expect(checks[3].Baseline).To(BeTrue())
+			Expect(checks[3].Status).To(Equal(rule.Warning))
+		})
+
+		It("should not depend on the status of findings", func() {
+			rep := newReport(
+				report.Check{Status: rule.Errored, Message: "errored", Targets: []rule.Target{rule.NewTarget("name", "foo")}},
+				report.Check{Status: rule.Failed, Message: "failed"},
+			)
+			rep.ApplyBaseline(baseline)
+
+			checks := rep.Providers[0].Rulesets[0].Rules[0].Checks
+			Expect(checks).To(HaveLen(2))
+			Expect(checks[0].Baseline).To(BeTrue())
+			Expect(checks[0].Status).To(Equal(rule.Errored))
+			Expect(checks[1].Baseline).To(BeTrue())
+			Expect(checks[1].Status).To(Equal(rule.Failed))
+		})
+
+		It("should not mark targets which were not findings in the baseline", func() {
+			rep := newReport(
+				report.Check{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "baz")}},
+			)
+			rep.ApplyBaseline(baseline)
+
+			checks := rep.Providers[0].Rulesets[0].Rules[0].Checks
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Baseline).To(BeFalse())
+		})
+
+		It("should merge checks when a baseline is applied again", func() {
+			rep := newReport(
+				report.Check{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "baz"), rule.NewTarget("name", "foo")}},
+			)
+			rep.ApplyBaseline(baseline)
+			rep.ApplyBaseline(newReport())
+
+			checks := rep.Providers[0].Rulesets[0].Rules[0].Checks
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Baseline).To(BeFalse())
+			Expect(checks[0].Targets).To(ConsistOf(rule.NewTarget("name", "baz"), rule.NewTarget("name", "foo")))
+		})
+
+		It("should only count new findings", func() {
+			rep := newReport(
+				report.Check{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "baz"), rule.NewTarget("name", "foo"), rule.NewTarget("name", "bar")}},
+			)
+			failCondition := report.FailCondition{Statuses: []rule.Status{rule.Failed}}
+			Expect(rep.NumFindings(failCondition)).To(Equal(3))
+
+			rep.ApplyBaseline(baseline)
+			Expect(rep.NumFindings(failCondition)).To(Equal(1))
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"cmp"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"time"
@@ -42,19 +43,45 @@ type ProviderDifference struct {
 // RulesetDifference contains the difference between two reports
 // for a ruleset and its rules.
 type RulesetDifference struct {
-	ID      string           `json:"id"`
-	Name    string           `json:"name"`
-	Version string           `json:"version"`
-	Rules   []RuleDifference `json:"rules"`
+	ID      string            `json:"id"`
+	Name    string            `json:"name"`
+	Version string            `json:"version"`
+	Summary DifferenceSummary `json:"summary"`
+	Rules   []RuleDifference  `json:"rules"`
+}
+
+// DifferenceSummary contains the number of target transitions per kind of a ruleset.
+type DifferenceSummary struct {
+	Improvements int `json:"improvements"`
+	Regressions  int `json:"regressions"`
+	// Unchanged is the number of targets whose status neither improved nor worsened.
+	// The unchanged targets themselves are not part of the difference.
+	Unchanged int `json:"unchanged"`
 }
 
 // RuleDifference contains the difference between two reports for a single rule.
+// Rules whose targets are all unchanged are not part of the difference.
 type RuleDifference struct {
 	ID       string             `json:"id"`
 	Name     string             `json:"name"`
 	Severity rule.SeverityLevel `json:"severity,omitempty"`
 	Added    []Check            `json:"added,omitempty"`
 	Removed  []Check            `json:"removed,omitempty"`
+	// Improvements contains the targets whose status improved, e.g. from Failed to Passed.
+	Improvements []TargetTransition `json:"improvements,omitempty"`
+	// Regressions contains the targets whose status worsened, e.g. from Passed to Failed.
+	Regressions []TargetTransition `json:"regressions,omitempty"`
+}
+
+// TargetTransition contains the status of a check target in the old and the new report.
+// The status is empty if the target is not reported in the respective report,
+// which is treated like a [rule.Passed] status when comparing statuses.
+type TargetTransition struct {
+	Target     rule.Target `json:"target,omitempty"`
+	OldStatus  rule.Status `json:"oldStatus,omitempty"`
+	NewStatus  rule.Status `json:"newStatus,omitempty"`
+	OldMessage string      `json:"oldMessage,omitempty"`
+	NewMessage string      `json:"newMessage,omitempty"`
 }
 
 // CreateDifference creates the difference between two reports.
@@ -126,11 +153,13 @@ func CreateDifference(oldReport, newReport Report, title string) (*DifferenceRep
 				if len(rulesetName) == 0 {
 					rulesetName = oldRuleset.Name
 				}
+				rulesDiff, summary := getRulesDifference(oldRuleset.Rules, newRuleset.Rules)
 				rulesetDiff = append(rulesetDiff, RulesetDifference{
 					ID:      id,
 					Name:    rulesetName,
 					Version: version,
-					Rules:   getRulesDifference(oldRuleset.Rules, newRuleset.Rules),
+					Summary: summary,
+					Rules:   rulesDiff,
 				})
 			}
 		}
@@ -170,9 +199,10 @@ func CreateDifference(oldReport, newReport Report, title string) (*DifferenceRep
 	return diff, nil
 }
 
-func getRulesDifference(oldRules, newRules []Rule) []RuleDifference {
+func getRulesDifference(oldRules, newRules []Rule) ([]RuleDifference, DifferenceSummary) {
 	var (
 		ruleDiff      []RuleDifference
+		summary       DifferenceSummary
 		addedChecks   = getCheckDifference(newRules, oldRules)
 		removedChecks = getCheckDifference(oldRules, newRules)
 	)
@@ -204,11 +234,131 @@ func getRulesDifference(oldRules, newRules []Rule) []RuleDifference {
 		})
 	}
 
+	for _, r := range getUniqueRules(oldRules, newRules) {
+		improvements, regressions, unchanged := getTargetTransitions(ruleChecks(oldRules, r.ID), ruleChecks(newRules, r.ID))
+		summary.Improvements += len(improvements)
+		summary.Regressions += len(regressions)
+		summary.Unchanged += unchanged
+
+		idx := slices.IndexFunc(ruleDiff, func(rd RuleDifference) bool {
+			return rd.ID == r.ID
+		})
+		if idx < 0 {
+			if len(improvements) == 0 && len(regressions) == 0 {
+				continue
+			}
+			ruleDiff = append(ruleDiff, RuleDifference{
+				ID:       r.ID,
+				Severity: r.Severity,
+				Name:     r.Name,
+			})
+			idx = len(ruleDiff) - 1
+		}
+		ruleDiff[idx].Improvements = improvements
+		ruleDiff[idx].Regressions = regressions
+	}
+
 	// sort rules by id
 	slices.SortFunc(ruleDiff, func(a, b RuleDifference) int {
 		return cmp.Compare(a.ID, b.ID)
 	})
-	return ruleDiff
+	return ruleDiff, summary
+}
+
+// getUniqueRules returns all unique rules contained in rules1 and rules2.
+// Rules contained in both are taken from rules2.
+func getUniqueRules(rules1, rules2 []Rule) []Rule {
+	rules := slices.Clone(rules2)
+	for _, r1 := range rules1 {
+		if !slices.ContainsFunc(rules2, func(r2 Rule) bool { return r1.ID == r2.ID }) {
+			rules = append(rules, r1)
+		}
+	}
+	return rules
+}
+
+// ruleChecks returns the checks of the rule with the given id.
+func ruleChecks(rules []Rule, ruleID string) []Check {
+	idx := slices.IndexFunc(rules, func(r Rule) bool {
+		return r.ID == ruleID
+	})
+	if idx < 0 {
+		return nil
+	}
+	return rules[idx].Checks
+}
+
+// getTargetTransitions matches the targets of the old and new checks of a rule and returns their improved
+// and worsened status transitions and the number of unchanged targets. Checks without targets are matched with each other.
+func getTargetTransitions(oldChecks, newChecks []Check) (improvements, regressions []TargetTransition, unchanged int) {
+	var (
+		oldTargets = targetChecks(oldChecks)
+		newTargets = targetChecks(newChecks)
+		keys       = slices.AppendSeq(slices.Collect(maps.Keys(oldTargets)), maps.Keys(newTargets))
+	)
+	slices.Sort(keys)
+
+	for _, key := range slices.Compact(keys) {
+		oldTarget, newTarget := oldTargets[key], newTargets[key]
+		transition := TargetTransition{
+			Target:     oldTarget.target,
+			OldStatus:  oldTarget.status,
+			NewStatus:  newTarget.status,
+			OldMessage: oldTarget.message,
+			NewMessage: newTarget.message,
+		}
+		if len(newTarget.target) > 0 {
+			transition.Target = newTarget.target
+		}
+
+		switch oldRank, newRank := transitionStatusRank(transition.OldStatus), transitionStatusRank(transition.NewStatus); {
+		case newRank < oldRank:
+			improvements = append(improvements, transition)
+		case newRank > oldRank:
+			regressions = append(regressions, transition)
+		default:
+			unchanged++
+		}
+	}
+	return improvements, regressions, unchanged
+}
+
+type targetCheck struct {
+	target  rule.Target
+	status  rule.Status
+	message string
+}
+
+// targetChecks returns the status of every target of the checks by canonical target.
+// Targets reported by multiple checks get the highest status.
+func targetChecks(checks []Check) map[string]targetCheck {
+	targets := map[string]targetCheck{}
+	for _, check := range checks {
+		for _, target := range checkTargets(check) {
+			key := canonicalTarget(target)
+			if existing, ok := targets[key]; ok && !existing.status.Less(check.Status) {
+				continue
+			}
+			targets[key] = targetCheck{target: target, status: check.Status, message: check.Message}
+		}
+	}
+	return targets
+}
+
+// transitionStatusRank returns the rank of a status when comparing the statuses of a target.
+// Statuses without a finding and targets that are not reported share the lowest rank,
+// so that e.g. a transition from Failed to Skipped is an improvement and one from Passed to Skipped is unchanged.
+func transitionStatusRank(status rule.Status) int {
+	switch status {
+	case rule.Warning:
+		return 1
+	case rule.Failed:
+		return 2
+	case rule.Errored:
+		return 3
+	default:
+		return 0
+	}
 }
 
 // getCheckDifference returns all rules with checks
@@ -166,6 +166,7 @@ var _ = Describe("diff", func() {
 								ID:      "ruleset-foo",
 								Name:    "Ruleset Foo",
 								Version: "v1",
+								Summary: report.DifferenceSummary{Improvements: 1, Unchanged: 2},
 								Rules: []report.RuleDifference{
 									{
 										ID:       "1",
@@ -188,6 +189,7 @@ var _ = Describe("diff", func() {
 												Message: "foo",
 											},
 										},
+										Improvements: []report.TargetTransition{{OldStatus: rule.Failed, NewStatus: rule.Passed, OldMessage: "foo", NewMessage: "foo"}},
 									},
 									{
 										ID:   "3",
@@ -240,6 +242,7 @@ var _ = Describe("diff", func() {
 								ID:      "ruleset-bar",
 								Name:    "Ruleset Bar",
 								Version: "v1",
+								Summary: report.DifferenceSummary{Regressions: 1, Unchanged: 1},
 								Rules: []report.RuleDifference{
 									{
 										ID:       "1",
@@ -266,6 +269,7 @@ var _ = Describe("diff", func() {
 												Message: "foo",
 											},
 										},
+										Regressions: []report.TargetTransition{{NewStatus: rule.Failed, NewMessage: "foo"}},
 									},
 								},
 							},
@@ -273,6 +277,7 @@ var _ = Describe("diff", func() {
 								ID:      "ruleset-foo",
 								Name:    "Ruleset Foo",
 								Version: "v1",
+								Summary: report.DifferenceSummary{Improvements: 1, Unchanged: 2},
 								Rules: []report.RuleDifference{
 									{
 										ID:       "1",
@@ -295,6 +300,7 @@ var _ = Describe("diff", func() {
 												Message: "foo",
 											},
 										},
+										Improvements: []report.TargetTransition{{OldStatus: rule.Failed, NewStatus: rule.Passed, OldMessage: "foo", NewMessage: "foo"}},
 									},
 									{
 										ID:   "3",
@@ -350,6 +356,7 @@ var _ = Describe("diff", func() {
 								ID:      "ruleset-foo",
 								Name:    "Ruleset Foo",
 								Version: "v1",
+								Summary: report.DifferenceSummary{Improvements: 1, Unchanged: 2},
 								Rules: []report.RuleDifference{
 									{
 										ID:       "1",
@@ -372,6 +379,7 @@ var _ = Describe("diff", func() {
 												Message: "foo",
 											},
 										},
+										Improvements: []report.TargetTransition{{OldStatus: rule.Failed, NewStatus: rule.Passed, OldMessage: "foo", NewMessage: "foo"}},
 									},
 									{
 										ID:   "3",
@@ -402,6 +410,7 @@ var _ = Describe("diff", func() {
 								ID:      "ruleset-foo",
 								Name:    "Ruleset Foo",
 								Version: "v1",
+								Summary: report.DifferenceSummary{Regressions: 1, Unchanged: 1},
 								Rules: []report.RuleDifference{
 									{
 										ID:       "1",
@@ -428,6 +437,7 @@ var _ = Describe("diff", func() {
 												Message: "foo",
 											},
 										},
+										Regressions: []report.TargetTransition{{NewStatus: rule.Failed, NewMessage: "foo"}},
 									},
 								},
 							},
@@ -493,6 +503,7 @@ var _ = Describe("diff", func() {
 								ID:      "ruleset-foo",
 								Name:    "Ruleset Foo",
 								Version: "v1",
+								Summary: report.DifferenceSummary{Improvements: 1, Unchanged: 1},
 								Rules: []report.RuleDifference{
 									{
 										ID:       "1",
@@ -519,6 +530,7 @@ var _ = Describe("diff", func() {
 												Message: "foo",
 											},
 										},
+										Improvements: []report.TargetTransition{{OldStatus: rule.Failed, OldMessage: "foo"}},
 									},
 								},
 							},
@@ -526,6 +538,7 @@ var _ = Describe("diff", func() {
 								ID:      "ruleset-foo",
 								Name:    "Ruleset Foo",
 								Version: "v1.1",
+								Summary: report.DifferenceSummary{Unchanged: 2},
 								Rules: []report.RuleDifference{
 									{
 										ID:       "2",
@@ -567,6 +580,7 @@ var _ = Describe("diff", func() {
 								ID:      "ruleset-foo",
 								Name:    "Ruleset Foo",
 								Version: "v1",
+								Summary: report.DifferenceSummary{Improvements: 1},
 								Rules: []report.RuleDifference{
 									{
 										ID:       "1",
@@ -578,6 +592,7 @@ var _ = Describe("diff", func() {
 												Message: "Warning",
 											},
 										},
+										Improvements: []report.TargetTransition{{OldStatus: rule.Warning, OldMessage: "Warning"}},
 									},
 								},
 							},
@@ -588,5 +603,71 @@ var _ = Describe("diff", func() {
 			Expect(diff).To(Equal(expectedDiff))
 			Expect(err).To(BeNil())
 		})
+		It("should split target transitions into improvements, regressions and unchanged", func() {
+			simpleReport1.Providers[0].Rulesets[0].Rules = []report.Rule{
+				{
+					ID: "1",
+					Checks: []report.Check{
+						{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "foo"), rule.NewTarget("name", "baz")}},
+						{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "bar")}},
+					},
+				},
+				{
+					ID:     "2",
+					Checks: []report.Check{{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "foo")}}},
+				},
+			}
+			simpleReport2.Providers[0].Rulesets[0].Rules = []report.Rule{
+				{
+					ID: "1",
+					Checks: []report.Check{
+						{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "bar"), rule.NewTarget("name", "baz")}},
+						{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "foo")}},
+					},
+				},
+				{
+					ID:     "2",
+					Checks: []report.Check{{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "foo")}}},
+				},
+			}
+
+			diff, err := report.CreateDifference(simpleReport1, simpleReport2, title)
+			Expect(err).To(BeNil())
+
+			rulesetDiff := diff.Providers[0].Rulesets[0]
+			Expect(rulesetDiff.Summary).To(Equal(report.DifferenceSummary{Improvements: 1, Regressions: 1, Unchanged: 2}))
+			Expect(rulesetDiff.Rules).To(HaveLen(1))
+			Expect(rulesetDiff.Rules[0].ID).To(Equal("1"))
+			Expect(rulesetDiff.Rules[0].Added).To(BeEmpty())
+			Expect(rulesetDiff.Rules[0].Removed).To(BeEmpty())
+			Expect(rulesetDiff.Rules[0].Improvements).To(Equal([]report.TargetTransition{
+				{Target: rule.NewTarget("name", "foo"), OldStatus: rule.Failed, NewStatus: rule.Passed, OldMessage: "failed", NewMessage: "passed"},
+			}))
+			Expect(rulesetDiff.Rules[0].Regressions).To(Equal([]report.TargetTransition{
+				{Target: rule.NewTarget("name", "bar"), OldStatus: rule.Passed, NewStatus: rule.Failed, OldMessage: "passed", NewMessage: "failed"},
+			}))
+		})
+		DescribeTable("should rank status transitions of targets",
+			func(oldStatus, newStatus rule.Status, expectedSummary report.DifferenceSummary) {
+				simpleReport1.Providers[0].Rulesets[0].Rules = []report.Rule{
+					{ID: "1", Checks: []report.Check{{Status: oldStatus, Message: "old", Targets: []rule.Target{rule.NewTarget("name", "foo")}}}},
+				}
+				simpleReport2.Providers[0].Rulesets[0].Rules = []report.Rule{
+					{ID: "1", Checks: []report.Check{{Status: newStatus, Message: "new", Targets: []rule.Target{rule.NewTarget("name", "foo")}}}},
+				}
+
+				diff, err := report.CreateDifference(simpleReport1, simpleReport2, title)
+				Expect(err).To(BeNil())
+				Expect(diff.Providers[0].Rulesets[0].Summary).To(Equal(expectedSummary))
+			},
+			Entry("Failed to Skipped is an improvement", rule.Failed, rule.Skipped, report.DifferenceSummary{Improvements: 1}),
+			Entry("Failed to Not Implemented is an improvement", rule.Failed, rule.NotImplemented, report.DifferenceSummary{Improvements: 1}),
+			Entry("Failed to Accepted is an improvement", rule.Failed, rule.Accepted, report.DifferenceSummary{Improvements: 1}),
+			Entry("Skipped to Failed is a regression", rule.Skipped, rule.Failed, report.DifferenceSummary{Regressions: 1}),
+			Entry("Not Implemented to Warning is a regression", rule.NotImplemented, rule.Warning, report.DifferenceSummary{Regressions: 1}),
+			Entry("Failed to Errored is a regression", rule.Failed, rule.Errored, report.DifferenceSummary{Regressions: 1}),
+			Entry("Passed to Skipped is unchanged", rule.Passed, rule.Skipped, report.DifferenceSummary{Unchanged: 1}),
+			Entry("Passed to Not Implemented is unchanged", rule.Passed, rule.NotImplemented, report.DifferenceSummary{Unchanged: 1}),
+		)
 	})
 })
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	// FrameworkDISASTIG is the ID of the DISA STIG framework. Its controls are the STIG Vuln IDs, e.g. V-242376.
+	FrameworkDISASTIG = "disa-stig"
+	// FrameworkNIST80053 is the ID of the NIST SP 800-53 framework. Its controls are OSCAL control IDs, e.g. ac-17.2.
+	FrameworkNIST80053 = "nist-800-53"
+	// FrameworkCIS is the ID of the CIS Kubernetes Benchmark framework. Its controls are recommendation numbers, e.g. 4.2.1.
+	FrameworkCIS = "cis"
+)
+
+const (
+	securityHardenedShootRulesetID = "security-hardened-shoot-cluster"
+	securityHardenedK8sRulesetID   = "security-hardened-k8s"
+)
+
+// FrameworkMappings maps rules to the controls of compliance frameworks by framework ID.
+// The mapping of each framework has the format of an [OSCALControlMapping], e.g.
+//
+//	nist-800-53:
+//	  disa-kubernetes-stig:
+//	    "242376": ["sc-8", "sc-13"]
+type FrameworkMappings map[string]OSCALControlMapping
+
+// FrameworkReport contains the results of a report grouped by the controls of a compliance framework.
+type FrameworkReport struct {
+	Time        time.Time `json:"time"`
+	DikiVersion string    `json:"dikiVersion"`
+	Framework   string    `json:"framework"`
+	Controls    []Control `json:"controls"`
+	// UnmappedRules contains the rules that are not mapped to any control of the framework.
+	UnmappedRules []ControlRule `json:"unmappedRules,omitempty"`
+}
+
+// Control contains the results of all rules that support a framework control.
+type Control struct {
+	ID string `json:"id"`
+	// Status is the highest priority status of the checks of the control.
+	// Skipped and not implemented checks are only considered if the control has no other checks.
+	Status rule.Status   `json:"status"`
+	Rules  []ControlRule `json:"rules"`
+}
+
+// ControlRule is a rule of a ruleset that supports a framework control.
+type ControlRule struct {
+	ProviderID     string             `json:"providerID"`
+	ProviderName   string             `json:"providerName"`
+	RulesetID      string             `json:"rulesetID"`
+	RulesetName    string             `json:"rulesetName"`
+	RulesetVersion string             `json:"rulesetVersion"`
+	ID             string             `json:"id"`
+	Name           string             `json:"name"`
+	Severity       rule.SeverityLevel `json:"severity,omitempty"`
+	Checks         []Check            `json:"checks"`
+}
+
+// FrameworkIDs returns the IDs of the frameworks with built-in or the given mappings.
+func FrameworkIDs(mappings FrameworkMappings) []string {
+	frameworkIDs := slices.Collect(maps.Keys(builtinFrameworkMappings))
+	for frameworkID := range mappings {
+		if !slices.Contains(frameworkIDs, frameworkID) {
+			frameworkIDs = append(frameworkIDs, frameworkID)
+		}
+	}
+	slices.Sort(frameworkIDs)
+	return frameworkIDs
+}
+
+// GroupByFramework groups the rules of a report by the controls of a framework. The given mappings take
+// precedence over the built-in mappings for the same rule. Rules without checks are not included.
+func GroupByFramework(rep *Report, frameworkID string, mappings FrameworkMappings) (*FrameworkReport, error) {
+	if !slices.Contains(FrameworkIDs(mappings), frameworkID) {
+		return nil, fmt.Errorf("no mapping for framework %s found", frameworkID)
+	}
+
+	var (
+		frameworkReport = &FrameworkReport{
+			Time:        rep.Time,
+			DikiVersion: rep.DikiVersion,
+			Framework:   frameworkID,
+		}
+		controls = map[string]*Control{}
+	)
+	for _, provider := range rep.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, r := range ruleset.Rules {
+				if len(r.Checks) == 0 {
+					continue
+				}
+
+				controlRule := ControlRule{
+					ProviderID:     provider.ID,
+					ProviderName:   provider.Name,
+					RulesetID:      ruleset.ID,
+					RulesetName:    ruleset.Name,
+					RulesetVersion: ruleset.Version,
+					ID:             r.ID,
+					Name:           r.Name,
+					Severity:       r.Severity,
+					Checks:         r.Checks,
+				}
+				controlIDs := frameworkControlIDs(frameworkID, ruleset.ID, r.ID, mappings)
+				if len(controlIDs) == 0 {
+					frameworkReport.UnmappedRules = append(frameworkReport.UnmappedRules, controlRule)
+					continue
+				}
+
+				for _, controlID := range controlIDs {
+					if _, ok := controls[controlID]; !ok {
+						controls[controlID] = &Control{ID: controlID}
+					}
+					controls[controlID].Rules = append(controls[controlID].Rules, controlRule)
+				}
+			}
+		}
+	}
+
+	frameworkReport.Controls = make([]Control, 0, len(controls))
+	for _, controlID := range slices.Sorted(maps.Keys(controls)) {
+		control := controls[controlID]
+		control.Status = controlStatus(control.Rules)
+		frameworkReport.Controls = append(frameworkReport.Controls, *control)
+	}
+	return frameworkReport, nil
+}
+
+// frameworkControlIDs returns the IDs of the framework controls a rule is mapped to.
+func frameworkControlIDs(frameworkID, rulesetID, ruleID string, mappings FrameworkMappings) []string {
+	if controlIDs, ok := mappings[frameworkID].ControlIDs(rulesetID, ruleID); ok {
+		return controlIDs
+	}
+	if controlIDs, ok := builtinFrameworkMappings[frameworkID].ControlIDs(rulesetID, ruleID); ok {
+		return controlIDs
+	}
+	if frameworkID == FrameworkDISASTIG && rulesetID == disaKubernetesSTIGRulesetID {
+		return []string{"V-" + ruleID}
+	}
+	return nil
+}
+
+func controlStatus(rules []ControlRule) rule.Status {
+	var status, notEvaluatedStatus rule.Status
+	for _, r := range rules {
+		for _, check := range r.Checks {
+			switch check.Status {
+			case rule.Skipped, rule.NotImplemented:
+				if len(notEvaluatedStatus) == 0 || notEvaluatedStatus.Less(check.Status) {
+					notEvaluatedStatus = check.Status
+				}
+			default:
+				if len(status) == 0 || status.Less(check.Status) {
+					status = check.Status
+				}
+			}
+		}
+	}
+	if len(status) == 0 {
+		return notEvaluatedStatus
+	}
+	return status
+}
+
+// controlSummaryText returns a summary string with the number of controls per status.
+func controlSummaryText(controls []Control) string {
+	statuses := map[rule.Status]int{}
+	for _, control := range controls {
+		statuses[control.Status]++
+	}
+
+	var texts []string
+	for _, status := range rule.Statuses() {
+		if num := statuses[status]; num != 0 {
+			texts = append(texts, fmt.Sprintf("%dx %s %c", num, status, rule.StatusIcon(status)))
+		}
+	}
+	return strings.Join(texts, ", ")
+}
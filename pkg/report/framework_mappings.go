@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+// builtinFrameworkMappings contains the built-in mappings of rules to framework controls.
+// Rules of the disa-kubernetes-stig ruleset are additionally mapped to the DISA STIG control with their own Vuln ID.
+// Rules of the security hardened rulesets are mapped to the DISA STIG and NIST SP 800-53 controls
+// referenced in their documentation, which are the closest counterparts for rules without a direct one.
+// The CIS mappings are derived from the intent of the rules and only contain rules with a clear counterpart
+// in the benchmark. All mappings can be extended or overridden per rule with a custom mapping.
+var builtinFrameworkMappings = FrameworkMappings{
+	FrameworkDISASTIG: {
+		securityHardenedShootRulesetID: {
+			"1000": {"V-242436"},
+			"1001": {"V-242443"},
+			"1002": {"V-242443"},
+			"1003": {"V-242436"},
+			"2000": {"V-242390"},
+			"2001": {"V-242393", "V-242394"},
+			"2002": {"V-242400"},
+			"2003": {"V-242434"},
+			"2004": {"V-242436"},
+			"2005": {"V-245541"},
+			"2006": {"V-245543"},
+			"2007": {"V-254800"},
+		},
+		securityHardenedK8sRulesetID: {
+			"2000": {"V-242417"},
+			"2001": {"V-254800"},
+			"2002": {"V-242417"},
+			"2003": {"V-254800"},
+			"2004": {"V-242414"},
+			"2005": {"V-242436"},
+			"2006": {"V-242382"},
+			"2007": {"V-242382"},
+			"2008": {"V-254800"},
+		},
+	},
+	FrameworkNIST80053: {
+		disaKubernetesSTIGRulesetID: {
+			"242376": {"ac-17.2", "sc-8"},
+			"242377": {"ac-17.2", "sc-8"},
+			"242378": {"ac-17.2", "sc-8"},
+			"242379": {"sc-8"},
+			"242380": {"sc-8"},
+			"242381": {"ac-6"},
+			"242382": {"ac-3"},
+			"242383": {"cm-6"},
+			"242384": {"cm-7"},
+			"242385": {"cm-7"},
+			"242386": {"cm-7"},
+			"242387": {"cm-7"},
+			"242388": {"cm-7"},
+			"242389": {"sc-8"},
+			"242390": {"ia-2"},
+			"242391": {"ia-2"},
+			"242392": {"ac-3"},
+			"242393": {"ac-17", "cm-7"},
+			"242394": {"ac-17", "cm-7"},
+			"242395": {"cm-7"},
+			"242396": {"si-2"},
+			"242397": {"cm-6"},
+			"242398": {"cm-6"},
+			"242399": {"cm-6"},
+			"242400": {"cm-7"},
+			"242402": {"au-12"},
+			"242403": {"au-2", "au-3", "au-12"},
+			"242404": {"cm-6"},
+			"242405": {"cm-5.6"},
+			"242406": {"cm-5.6"},
+			"242407": {"cm-5.6"},
+			"242408": {"cm-5.6"},
+			"242409": {"cm-7"},
+			"242410": {"cm-7"},
+			"242411": {"cm-7"},
+			"242412": {"cm-7"},
+			"242413": {"cm-7"},
+			"242414": {"cm-7"},
+			"242415": {"ia-5.7"},
+			"242417": {"sc-2"},
+			"242418": {"sc-8", "sc-13"},
+			"242419": {"ia-5.2"},
+			"242420": {"ia-5.2"},
+			"242421": {"ia-5.2"},
+			"242422": {"sc-8"},
+			"242423": {"ia-5.2"},
+			"242424": {"sc-8"},
+			"242425": {"sc-8"},
+			"242426": {"ia-5.2"},
+			"242427": {"sc-8"},
+			"242428": {"sc-8"},
+			"242429": {"ia-5.2"},
+			"242430": {"ia-5.2"},
+			"242431": {"ia-5.2"},
+			"242432": {"sc-8"},
+			"242433": {"sc-8"},
+			"242434": {"cm-6"},
+			"242436": {"cm-6"},
+			"242437": {"ac-6"},
+			"242438": {"sc-5"},
+			"242442": {"si-2"},
+			"242443": {"si-2"},
+			"242444": {"cm-5.6"},
+			"242445": {"cm-5.6"},
+			"242446": {"cm-5.6"},
+			"242447": {"cm-5.6"},
+			"242448": {"cm-5.6"},
+			"242449": {"cm-5.6"},
+			"242450": {"cm-5.6"},
+			"242451": {"sc-12"},
+			"242452": {"cm-5.6"},
+			"242453": {"cm-5.6"},
+			"242454": {"cm-5.6"},
+			"242455": {"cm-5.6"},
+			"242456": {"cm-5.6"},
+			"242457": {"cm-5.6"},
+			"242459": {"cm-5.6"},
+			"242460": {"cm-5.6"},
+			"242461": {"au-12"},
+			"242462": {"au-4"},
+			"242463": {"au-4", "au-11"},
+			"242464": {"au-11"},
+			"242465": {"au-12"},
+			"242466": {"sc-12"},
+			"242467": {"sc-12"},
+			"245541": {"sc-10"},
+			"245542": {"ia-5"},
+			"245543": {"ia-5"},
+			"245544": {"ia-5.2"},
+			"254800": {"ac-6"},
+			"254801": {"ac-6"},
+		},
+		securityHardenedShootRulesetID: {
+			"1000": {"cm-6"},
+			"1001": {"si-2"},
+			"1002": {"si-2"},
+			"1003": {"si-7"},
+			"2000": {"ia-2"},
+			"2001": {"ac-17", "cm-7"},
+			"2002": {"cm-7"},
+			"2003": {"cm-6"},
+			"2004": {"cm-6"},
+			"2005": {"sc-10"},
+			"2006": {"ia-5"},
+			"2007": {"ac-6"},
+		},
+		securityHardenedK8sRulesetID: {
+			"2000": {"ac-4", "sc-7"},
+			"2001": {"ac-6"},
+			"2002": {"sc-4"},
+			"2003": {"ac-6"},
+			"2004": {"sc-7"},
+			"2005": {"cm-7"},
+			"2006": {"ac-6"},
+			"2007": {"ac-6"},
+			"2008": {"ac-6"},
+		},
+	},
+	FrameworkCIS: {
+		disaKubernetesSTIGRulesetID: {
+			"242379": {"2.3"},
+			"242380": {"2.6"},
+			"242381": {"1.3.3"},
+			"242382": {"1.2.7", "1.2.8"},
+			"242383": {"5.7.4"},
+			"242384": {"1.4.2"},
+			"242385": {"1.3.7"},
+			"242387": {"4.2.4"},
+			"242390": {"1.2.1"},
+			"242391": {"4.2.1"},
+			"242392": {"4.2.2"},
+			"242402": {"1.2.17"},
+			"242404": {"4.2.8"},
+			"242409": {"1.3.2"},
+			"242414": {"5.2.13"},
+			"242415": {"5.4.1"},
+			"242418": {"1.2.30"},
+			"242419": {"1.2.26"},
+			"242420": {"4.2.3"},
+			"242421": {"1.3.5"},
+			"242422": {"1.2.25"},
+			"242423": {"2.2"},
+			"242424": {"4.2.10"},
+			"242425": {"4.2.10"},
+			"242426": {"2.5"},
+			"242427": {"2.1"},
+			"242428": {"2.1"},
+			"242429": {"1.2.27"},
+			"242430": {"1.2.24"},
+			"242431": {"1.2.24"},
+			"242432": {"2.4"},
+			"242433": {"2.4"},
+			"242434": {"4.2.6"},
+			"242437": {"5.2.1"},
+			"242438": {"1.2.21"},
+			"242462": {"1.2.20"},
+			"242463": {"1.2.19"},
+			"242464": {"1.2.18"},
+			"242465": {"1.2.17"},
+			"245541": {"4.2.5"},
+			"245543": {"1.2.2"},
+			"245544": {"1.2.4"},
+			"254800": {"5.2.1"},
+		},
+		securityHardenedShootRulesetID: {
+			"2000": {"1.2.1"},
+			"2003": {"4.2.6"},
+			"2005": {"4.2.5"},
+			"2007": {"5.2.1"},
+		},
+		securityHardenedK8sRulesetID: {
+			"2000": {"5.3.2"},
+			"2001": {"5.2.5"},
+			"2006": {"5.1.3"},
+			"2007": {"5.1.3"},
+			"2008": {"5.2.12"},
+		},
+	},
+}
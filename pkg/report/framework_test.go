@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("framework", func() {
+	var rep *report.Report
+
+	BeforeEach(func() {
+		rep = &report.Report{
+			DikiVersion: "1",
+			Providers: []report.Provider{
+				{
+					ID:   "gardener",
+					Name: "Gardener",
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "disa-kubernetes-stig",
+							Name:    "DISA Kubernetes Security Technical Implementation Guide",
+							Version: "v2r3",
+							Rules: []report.Rule{
+								{ID: "242376", Name: "foo", Checks: []report.Check{{Status: rule.Passed, Message: "foo"}}},
+								{ID: "242390", Name: "bar", Severity: rule.SeverityHigh, Checks: []report.Check{
+									{Status: rule.Failed, Message: "bar", Targets: []rule.Target{rule.NewTarget("name", "foo")}},
+									{Status: rule.Passed, Message: "bar"},
+								}},
+								{ID: "242391", Name: "baz", Checks: []report.Check{{Status: rule.Skipped, Message: "baz"}}},
+								{ID: "242392", Name: "qux"},
+							},
+						},
+					},
+				},
+				{
+					ID:   "garden",
+					Name: "Garden",
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "security-hardened-shoot-cluster",
+							Name:    "Security Hardened Shoot Cluster",
+							Version: "v0.2.1",
+							Rules: []report.Rule{
+								{ID: "2000", Name: "foo", Checks: []report.Check{{Status: rule.Passed, Message: "foo"}}},
+								{ID: "9000", Name: "bar", Checks: []report.Check{{Status: rule.Warning, Message: "bar"}}},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#FrameworkIDs", func() {
+		It("should return the built-in and the given frameworks", func() {
+			Expect(report.FrameworkIDs(nil)).To(Equal([]string{report.FrameworkCIS, report.FrameworkDISASTIG, report.FrameworkNIST80053}))
+			Expect(report.FrameworkIDs(report.FrameworkMappings{"iso-27001": {}, report.FrameworkDISASTIG: {}})).To(Equal([]string{report.FrameworkCIS, report.FrameworkDISASTIG, "iso-27001", report.FrameworkNIST80053}))
+		})
+	})
+
+	Describe("#GroupByFramework", func() {
+		It("should group rules by the built-in DISA STIG mappings", func() {
+			frameworkReport, err := report.GroupByFramework(rep, report.FrameworkDISASTIG, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(frameworkReport.Framework).To(Equal(report.FrameworkDISASTIG))
+			Expect(frameworkReport.DikiVersion).To(Equal("1"))
+
+			var controls []string
+			for _, control := range frameworkReport.Controls {
+				controls = append(controls, control.ID+"="+string(control.Status))
+			}
+			Expect(controls).To(Equal([]string{"V-242376=Passed", "V-242390=Failed", "V-242391=Skipped"}))
+
+			Expect(frameworkReport.Controls[1].Rules).To(HaveLen(2))
+			Expect(frameworkReport.Controls[1].Rules[0].RulesetID).To(Equal("disa-kubernetes-stig"))
+			Expect(frameworkReport.Controls[1].Rules[0].Severity).To(Equal(rule.SeverityHigh))
+			Expect(frameworkReport.Controls[1].Rules[1].ProviderID).To(Equal("garden"))
+			Expect(frameworkReport.Controls[1].Rules[1].ID).To(Equal("2000"))
+
+			Expect(frameworkReport.UnmappedRules).To(HaveLen(1))
+			Expect(frameworkReport.UnmappedRules[0].ID).To(Equal("9000"))
+		})
+
+		It("should map the rules of the security hardened Kubernetes ruleset to DISA STIG controls", func() {
+			rep.Providers[1].Rulesets = []report.Ruleset{{
+				ID:      "security-hardened-k8s",
+				Version: "v0.1.0",
+				Rules: []report.Rule{
+					{ID: "2001", Name: "foo", Checks: []report.Check{{Status: rule.Passed, Message: "foo"}}},
+					{ID: "2008", Name: "bar", Checks: []report.Check{{Status: rule.Failed, Message: "bar"}}},
+				},
+			}}
+
+			frameworkReport, err := report.GroupByFramework(rep, report.FrameworkDISASTIG, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(frameworkReport.Controls[3].ID).To(Equal("V-254800"))
+			Expect(frameworkReport.Controls[3].Status).To(Equal(rule.Failed))
+			Expect(frameworkReport.Controls[3].Rules).To(HaveLen(2))
+			Expect(frameworkReport.UnmappedRules).To(BeEmpty())
+		})
+
+		It("should group rules by the built-in CIS mappings", func() {
+			frameworkReport, err := report.GroupByFramework(rep, report.FrameworkCIS, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			var controls []string
+			for _, control := range frameworkReport.Controls {
+				controls = append(controls, control.ID+"="+string(control.Status))
+			}
+			Expect(controls).To(Equal([]string{"1.2.1=Failed", "4.2.1=Skipped"}))
+			Expect(frameworkReport.Controls[0].Rules).To(HaveLen(2))
+
+			Expect(frameworkReport.UnmappedRules).To(HaveLen(2))
+			Expect(frameworkReport.UnmappedRules[0].ID).To(Equal("242376"))
+			Expect(frameworkReport.UnmappedRules[1].ID).To(Equal("9000"))
+		})
+
+		It("should prefer the given mappings over the built-in ones", func() {
+			mappings := report.FrameworkMappings{
+				report.FrameworkNIST80053: {
+					"disa-kubernetes-stig": {
+						"242376": {"sc-8", "sc-13"},
+						"242391": {"sc-8"},
+					},
+				},
+				report.FrameworkDISASTIG: {
+					"security-hardened-shoot-cluster": {
+						"9000": {"V-242376"},
+					},
+				},
+			}
+
+			frameworkReport, err := report.GroupByFramework(rep, report.FrameworkNIST80053, mappings)
+			Expect(err).ToNot(HaveOccurred())
+
+			var controls []string
+			for _, control := range frameworkReport.Controls {
+				controls = append(controls, control.ID+"="+string(control.Status))
+			}
+			Expect(controls).To(Equal([]string{"ia-2=Failed", "sc-13=Passed", "sc-8=Passed"}))
+			Expect(frameworkReport.Controls[0].Rules).To(HaveLen(2))
+			Expect(frameworkReport.Controls[2].Rules).To(HaveLen(2))
+			Expect(frameworkReport.UnmappedRules).To(HaveLen(1))
+			Expect(frameworkReport.UnmappedRules[0].ID).To(Equal("9000"))
+
+			frameworkReport, err = report.GroupByFramework(rep, report.FrameworkDISASTIG, mappings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(frameworkReport.Controls[0].ID).To(Equal("V-242376"))
+			Expect(frameworkReport.Controls[0].Status).To(Equal(rule.Warning))
+			Expect(frameworkReport.UnmappedRules).To(BeEmpty())
+		})
+
+		It("should return an error for frameworks without mappings", func() {
+			_, err := report.GroupByFramework(rep, "iso-27001", nil)
+			Expect(err).To(MatchError("no mapping for framework iso-27001 found"))
+		})
+
+		It("should be rendered in html format", func() {
+			frameworkReport, err := report.GroupByFramework(rep, report.FrameworkDISASTIG, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			renderer, err := report.NewHTMLRenderer()
+			Expect(err).ToNot(HaveOccurred())
+
+			buf := &bytes.Buffer{}
+			Expect(renderer.Render(buf, frameworkReport)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("V-242390"))
+			Expect(buf.String()).To(ContainSubstring("Rules not mapped to controls"))
+		})
+	})
+})
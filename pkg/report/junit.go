@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// JUnitRenderer renders Diki reports in JUnit XML format.
+// Every ruleset of a provider is rendered as a test suite and every rule as a test case.
+type JUnitRenderer struct{}
+
+// NewJUnitRenderer creates a JUnitRenderer.
+func NewJUnitRenderer() *JUnitRenderer {
+	return &JUnitRenderer{}
+}
+
+// Render writes a Diki report in JUnit XML format into the passed writer.
+func (r *JUnitRenderer) Render(w io.Writer, report any) error {
+	rep, ok := report.(*Report)
+	if !ok {
+		return fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitFromReport(rep)); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string           `xml:"name,attr"`
+	ID         string           `xml:"id,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	Timestamp  string           `xml:"timestamp,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	TestCases  []junitTestCase  `xml:"testcase"`
+}
+
+type junitProperties struct {
+	Properties []junitProperty `xml:"property"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name       string           `xml:"name,attr"`
+	ClassName  string           `xml:"classname,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	Failure    *junitResult     `xml:"failure,omitempty"`
+	Error      *junitResult     `xml:"error,omitempty"`
+	Skipped    *junitResult     `xml:"skipped,omitempty"`
+	SystemOut  string           `xml:"system-out,omitempty"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+func junitFromReport(rep *Report) junitTestSuites {
+	testSuites := junitTestSuites{
+		Name:       "diki",
+		TestSuites: []junitTestSuite{},
+	}
+	for _, provider := range rep.Providers {
+		for _, ruleset := range provider.Rulesets {
+			testSuite := junitTestSuite{
+				Name:      fmt.Sprintf("%s - %s %s", provider.Name, ruleset.Name, ruleset.Version),
+				ID:        fmt.Sprintf("%s/%s/%s", provider.ID, ruleset.ID, ruleset.Version),
+				Timestamp: rep.Time.Format(time.RFC3339),
+				TestCases: []junitTestCase{},
+			}
+			if len(provider.Metadata) > 0 {
+				testSuite.Properties = &junitProperties{}
+				for _, key := range sortedKeys(provider.Metadata) {
+					testSuite.Properties.Properties = append(testSuite.Properties.Properties, junitProperty{Name: key, Value: provider.Metadata[key]})
+				}
+			}
+
+			for _, r := range ruleset.Rules {
+				testCase := junitTestCaseFromRule(fmt.Sprintf("%s.%s", provider.ID, ruleset.ID), r)
+				switch {
+				case testCase.Failure != nil:
+					testSuite.Failures++
+				case testCase.Error != nil:
+					testSuite.Errors++
+				case testCase.Skipped != nil:
+					testSuite.Skipped++
+				}
+				testSuite.Tests++
+				testSuite.TestCases = append(testSuite.TestCases, testCase)
+			}
+
+			testSuites.Tests += testSuite.Tests
+			testSuites.Failures += testSuite.Failures
+			testSuites.Errors += testSuite.Errors
+			testSuites.Skipped += testSuite.Skipped
+			testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+		}
+	}
+	return testSuites
+}
+
+// junitTestCaseFromRule converts a rule to a test case. Failed checks take precedence over errored ones.
+// A rule is skipped only if none of its checks passed, warned, failed or errored. Warnings do not fail the
+// test case, but are written to its system-out so that they are visible in CI systems.
+func junitTestCaseFromRule(className string, r Rule) junitTestCase {
+	testCase := junitTestCase{
+		Name:      fmt.Sprintf("%s - %s", r.ID, r.Name),
+		ClassName: className,
+	}
+	if len(r.Severity) > 0 {
+		testCase.Properties = &junitProperties{
+			Properties: []junitProperty{{Name: "severity", Value: string(r.Severity)}},
+		}
+	}
+
+	var (
+		failed, errored, skipped, other []Check
+		skippedStatuses                 = []rule.Status{rule.Skipped, rule.Accepted, rule.NotImplemented}
+	)
+	for _, check := range r.Checks {
+		switch {
+		case check.Status == rule.Failed:
+			failed = append(failed, check)
+		case check.Status == rule.Errored:
+			errored = append(errored, check)
+		case slices.Contains(skippedStatuses, check.Status):
+			skipped = append(skipped, check)
+		default:
+			other = append(other, check)
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		testCase.Failure = &junitResult{
+			Message: failed[0].Message,
+			Type:    string(rule.Failed),
+			Body:    junitChecksText(append(failed, errored...)),
+		}
+	case len(errored) > 0:
+		testCase.Error = &junitResult{
+			Message: errored[0].Message,
+			Type:    string(rule.Errored),
+			Body:    junitChecksText(errored),
+		}
+	case len(skipped) > 0 && len(other) == 0:
+		testCase.Skipped = &junitResult{
+			Message: skipped[0].Message,
+			Body:    junitChecksText(skipped),
+		}
+		return testCase
+	}
+
+	testCase.SystemOut = junitChecksText(append(other, skipped...))
+	return testCase
+}
+
+func junitChecksText(checks []Check) string {
+	texts := make([]string, 0, len(checks))
+	for _, check := range checks {
+		texts = append(texts, checkText(check))
+	}
+	return strings.Join(texts, "\n\n")
+}
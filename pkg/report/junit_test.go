@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("junit", func() {
+	type result struct {
+		Message string `xml:"message,attr"`
+		Body    string `xml:",chardata"`
+	}
+	type testCase struct {
+		Name      string  `xml:"name,attr"`
+		ClassName string  `xml:"classname,attr"`
+		Failure   *result `xml:"failure"`
+		Error     *result `xml:"error"`
+		Skipped   *result `xml:"skipped"`
+		SystemOut string  `xml:"system-out"`
+	}
+	type testSuites struct {
+		Tests      int `xml:"tests,attr"`
+		Failures   int `xml:"failures,attr"`
+		Errors     int `xml:"errors,attr"`
+		Skipped    int `xml:"skipped,attr"`
+		TestSuites []struct {
+			Name      string     `xml:"name,attr"`
+			ID        string     `xml:"id,attr"`
+			Tests     int        `xml:"tests,attr"`
+			TestCases []testCase `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+
+	var rep *report.Report
+
+	BeforeEach(func() {
+		rep = &report.Report{
+			Time:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DikiVersion: "1",
+			Providers: []report.Provider{
+				{
+					ID:   "provider-foo",
+					Name: "Provider Foo",
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:   "1",
+									Name: "Rule 1",
+									Checks: []report.Check{
+										{Status: rule.Passed, Message: "passed"},
+										{Status: rule.Errored, Message: "errored"},
+										{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "foo")}},
+									},
+								},
+								{
+									ID:   "2",
+									Name: "Rule 2",
+									Checks: []report.Check{
+										{Status: rule.Errored, Message: "errored"},
+									},
+								},
+								{
+									ID:   "3",
+									Name: "Rule 3",
+									Checks: []report.Check{
+										{Status: rule.Accepted, Message: "accepted justification"},
+										{Status: rule.NotImplemented, Message: "not implemented"},
+									},
+								},
+								{
+									ID:   "4",
+									Name: "Rule 4",
+									Checks: []report.Check{
+										{Status: rule.Passed, Message: "passed"},
+										{Status: rule.Skipped, Message: "skipped justification"},
+									},
+								},
+								{
+									ID:   "5",
+									Name: "Rule 5",
+									Checks: []report.Check{
+										{Status: rule.Warning, Message: "warning"},
+										{Status: rule.NotImplemented, Message: "not implemented"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should render a test suite per ruleset and a test case per rule", func() {
+		buf := &bytes.Buffer{}
+		Expect(report.NewJUnitRenderer().Render(buf, rep)).To(Succeed())
+
+		suites := testSuites{}
+		Expect(xml.Unmarshal(buf.Bytes(), &suites)).To(Succeed())
+		Expect(suites.Tests).To(Equal(5))
+		Expect(suites.Failures).To(Equal(1))
+		Expect(suites.Errors).To(Equal(1))
+		Expect(suites.Skipped).To(Equal(1))
+
+		Expect(suites.TestSuites).To(HaveLen(1))
+		Expect(suites.TestSuites[0].Name).To(Equal("Provider Foo - Ruleset Foo v1"))
+		Expect(suites.TestSuites[0].ID).To(Equal("provider-foo/ruleset-foo/v1"))
+
+		testCases := suites.TestSuites[0].TestCases
+		Expect(testCases).To(HaveLen(5))
+
+		Expect(testCases[0].Name).To(Equal("1 - Rule 1"))
+		Expect(testCases[0].ClassName).To(Equal("provider-foo.ruleset-foo"))
+		Expect(testCases[0].Failure).To(Equal(&result{Message: "failed", Body: "Failed: failed\n- name: foo\n\nErrored: errored"}))
+		Expect(testCases[0].Error).To(BeNil())
+		Expect(testCases[0].SystemOut).To(Equal("Passed: passed"))
+
+		Expect(testCases[1].Error).To(Equal(&result{Message: "errored", Body: "Errored: errored"}))
+
+		Expect(testCases[2].Skipped).To(Equal(&result{Message: "accepted justification", Body: "Accepted: accepted justification\n\nNot Implemented: not implemented"}))
+
+		Expect(testCases[3].Failure).To(BeNil())
+		Expect(testCases[3].Skipped).To(BeNil())
+		Expect(testCases[3].SystemOut).To(Equal("Passed: passed\n\nSkipped: skipped justification"))
+
+		Expect(testCases[4].Failure).To(BeNil())
+		Expect(testCases[4].Skipped).To(BeNil())
+		Expect(testCases[4].SystemOut).To(Equal("Warning: warning\n\nNot Implemented: not implemented"))
+	})
+
+	It("should return error for unsupported report types", func() {
+		Expect(report.NewJUnitRenderer().Render(&bytes.Buffer{}, &report.MergedReport{})).To(MatchError("unsupported report type: *report.MergedReport"))
+	})
+})
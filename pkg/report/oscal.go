@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	oscalVersion   = "1.1.2"
+	oscalNamespace = "https://github.com/gardener/diki"
+	// oscalMetadataNamespace is the namespace of props created from report and provider metadata.
+	oscalMetadataNamespace = oscalNamespace + "/metadata"
+)
+
+// oscalTokenRegexp matches the OSCAL token datatype, which is required for prop names.
+var oscalTokenRegexp = regexp.MustCompile(`^(\p{L}|_)(\p{L}|\p{N}|[.\-_])*$`)
+
+// OSCALControlMapping maps rules to OSCAL control IDs by ruleset ID and rule ID, e.g.
+//
+//	disa-kubernetes-stig:
+//	  "242376": ["sc-8", "sc-13"]
+type OSCALControlMapping map[string]map[string][]string
+
+// ControlIDs returns the control IDs a rule of a ruleset is mapped to.
+func (m OSCALControlMapping) ControlIDs(rulesetID, ruleID string) ([]string, bool) {
+	controlIDs, ok := m[rulesetID][ruleID]
+	return controlIDs, ok
+}
+
+// OSCALRenderer renders Diki reports as NIST OSCAL assessment results.
+type OSCALRenderer struct {
+	controlMapping OSCALControlMapping
+}
+
+// NewOSCALRenderer creates an OSCALRenderer. Rules without an entry in the
+// control mapping are linked to a control with ID "<ruleset-id>-<rule-id>".
+func NewOSCALRenderer(controlMapping OSCALControlMapping) *OSCALRenderer {
+	return &OSCALRenderer{controlMapping: controlMapping}
+}
+
+// Render writes a Diki report as OSCAL assessment results in json format into the passed writer.
+func (r *OSCALRenderer) Render(w io.Writer, report any) error {
+	rep, ok := report.(*Report)
+	if !ok {
+		return fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	if err := validateOSCALMetadata(rep); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(oscalDocument{AssessmentResults: r.assessmentResults(rep)})
+}
+
+// validateOSCALMetadata checks that the keys of the report and provider metadata
+// are valid OSCAL tokens, since they are used as prop names.
+func validateOSCALMetadata(rep *Report) error {
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(rep.Metadata)) {
+		if !oscalTokenRegexp.MatchString(key) {
+			errs = append(errs, fmt.Errorf("report metadata key %q is not a valid OSCAL token", key))
+		}
+	}
+	for _, provider := range rep.Providers {
+		for _, key := range slices.Sorted(maps.Keys(provider.Metadata)) {
+			if !oscalTokenRegexp.MatchString(key) {
+				errs = append(errs, fmt.Errorf("metadata key %q of provider with id %s is not a valid OSCAL token", key, provider.ID))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type oscalDocument struct {
+	AssessmentResults oscalAssessmentResults `json:"assessment-results"`
+}
+
+type oscalAssessmentResults struct {
+	UUID     string        `json:"uuid"`
+	Metadata oscalMetadata `json:"metadata"`
+	ImportAP oscalImportAP `json:"import-ap"`
+	Results  []oscalResult `json:"results"`
+}
+
+type oscalMetadata struct {
+	Title        string      `json:"title"`
+	LastModified time.Time   `json:"last-modified"`
+	Version      string      `json:"version"`
+	OSCALVersion string      `json:"oscal-version"`
+	Props        []oscalProp `json:"props,omitempty"`
+}
+
+type oscalImportAP struct {
+	Href string `json:"href"`
+}
+
+type oscalProp struct {
+	Name  string `json:"name"`
+	NS    string `json:"ns,omitempty"`
+	Value string `json:"value"`
+}
+
+type oscalResult struct {
+	UUID             string                `json:"uuid"`
+	Title            string                `json:"title"`
+	Description      string                `json:"description"`
+	Start            time.Time             `json:"start"`
+	Props            []oscalProp           `json:"props,omitempty"`
+	ReviewedControls oscalReviewedControls `json:"reviewed-controls"`
+	Observations     []oscalObservation    `json:"observations,omitempty"`
+	Findings         []oscalFinding        `json:"findings,omitempty"`
+}
+
+type oscalReviewedControls struct {
+	ControlSelections []oscalControlSelection `json:"control-selections"`
+}
+
+type oscalControlSelection struct {
+	IncludeControls []oscalControlID `json:"include-controls,omitempty"`
+}
+
+type oscalControlID struct {
+	ControlID string `json:"control-id"`
+}
+
+type oscalObservation struct {
+	UUID        string         `json:"uuid"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Props       []oscalProp    `json:"props,omitempty"`
+	Methods     []string       `json:"methods"`
+	Types       []string       `json:"types,omitempty"`
+	Subjects    []oscalSubject `json:"subjects,omitempty"`
+	Collected   time.Time      `json:"collected"`
+}
+
+type oscalSubject struct {
+	SubjectUUID string      `json:"subject-uuid"`
+	Type        string      `json:"type"`
+	Title       string      `json:"title,omitempty"`
+	Props       []oscalProp `json:"props,omitempty"`
+}
+
+type oscalFinding struct {
+	UUID                string                    `json:"uuid"`
+	Title               string                    `json:"title"`
+	Description         string                    `json:"description"`
+	Target              oscalFindingTarget        `json:"target"`
+	RelatedObservations []oscalRelatedObservation `json:"related-observations,omitempty"`
+}
+
+type oscalFindingTarget struct {
+	Type     string                   `json:"type"`
+	TargetID string                   `json:"target-id"`
+	Status   oscalObjectiveStatusInfo `json:"status"`
+}
+
+type oscalObjectiveStatusInfo struct {
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type oscalRelatedObservation struct {
+	ObservationUUID string `json:"observation-uuid"`
+}
+
+func (r *OSCALRenderer) assessmentResults(rep *Report) oscalAssessmentResults {
+	reportUUID := oscalUUID(rep.Time.Format(time.RFC3339Nano))
+	assessmentResults := oscalAssessmentResults{
+		UUID: reportUUID,
+		Metadata: oscalMetadata{
+			Title:        "Diki Assessment Results",
+			LastModified: rep.Time,
+			Version:      rep.DikiVersion,
+			OSCALVersion: oscalVersion,
+			Props:        oscalPropsWithNamespace(flattenMetadata(rep.Metadata), oscalMetadataNamespace),
+		},
+		ImportAP: oscalImportAP{Href: "#"},
+		Results:  []oscalResult{},
+	}
+
+	for _, provider := range rep.Providers {
+		for _, ruleset := range provider.Rulesets {
+			resultUUID := oscalUUID(reportUUID, provider.ID, ruleset.ID, ruleset.Version)
+			result := oscalResult{
+				UUID:        resultUUID,
+				Title:       fmt.Sprintf("%s %s", ruleset.Name, ruleset.Version),
+				Description: fmt.Sprintf("Results of ruleset %s with version %s for provider %s.", ruleset.ID, ruleset.Version, provider.Name),
+				Start:       rep.Time,
+				Props: oscalProps(map[string]string{
+					"provider-id":     provider.ID,
+					"ruleset-id":      ruleset.ID,
+					"ruleset-version": ruleset.Version,
+				}),
+			}
+			result.Props = append(result.Props, oscalPropsWithNamespace(provider.Metadata, oscalMetadataNamespace)...)
+
+			var controlIDs []string
+			for _, rr := range ruleset.Rules {
+				var observationUUIDs []string
+				for checkIdx, check := range rr.Checks {
+					observation := oscalCheckObservation(resultUUID, rr, checkIdx, check, rep.Time)
+					result.Observations = append(result.Observations, observation)
+					observationUUIDs = append(observationUUIDs, observation.UUID)
+				}
+
+				state, reason := oscalFindingStatus(rr.Checks)
+				for _, controlID := range r.controlIDs(ruleset.ID, rr.ID) {
+					finding := oscalFinding{
+						UUID:        oscalUUID(resultUUID, rr.ID, controlID),
+						Title:       ruleTitle(rr.ID, rr.Severity, rr.Name),
+						Description: fmt.Sprintf("Rule %s of ruleset %s assessing control %s.", rr.ID, ruleset.ID, controlID),
+						Target: oscalFindingTarget{
+							Type:     "objective-id",
+							TargetID: controlID,
+							Status:   oscalObjectiveStatusInfo{State: state, Reason: reason},
+						},
+					}
+					for _, observationUUID := range observationUUIDs {
+						finding.RelatedObservations = append(finding.RelatedObservations, oscalRelatedObservation{ObservationUUID: observationUUID})
+					}
+					result.Findings = append(result.Findings, finding)
+					controlIDs = append(controlIDs, controlID)
+				}
+			}
+
+			slices.Sort(controlIDs)
+			controlSelection := oscalControlSelection{}
+			for _, controlID := range slices.Compact(controlIDs) {
+				controlSelection.IncludeControls = append(controlSelection.IncludeControls, oscalControlID{ControlID: controlID})
+			}
+			result.ReviewedControls.ControlSelections = []oscalControlSelection{controlSelection}
+			assessmentResults.Results = append(assessmentResults.Results, result)
+		}
+	}
+	return assessmentResults
+}
+
+func (r *OSCALRenderer) controlIDs(rulesetID, ruleID string) []string {
+	if controlIDs, ok := r.controlMapping.ControlIDs(rulesetID, ruleID); ok && len(controlIDs) > 0 {
+		return controlIDs
+	}
+	return []string{fmt.Sprintf("%s-%s", rulesetID, ruleID)}
+}
+
+func oscalCheckObservation(resultUUID string, r Rule, checkIdx int, check Check, collected time.Time) oscalObservation {
+	observationUUID := oscalUUID(resultUUID, r.ID, fmt.Sprint(checkIdx))
+	observation := oscalObservation{
+		UUID:        observationUUID,
+		Title:       fmt.Sprintf("%s %s", r.ID, check.Status),
+		Description: check.Message,
+		Props: oscalProps(map[string]string{
+			"rule-id": r.ID,
+			"status":  string(check.Status),
+		}),
+		Methods:   []string{"TEST"},
+		Types:     []string{"control-objective"},
+		Collected: collected,
+	}
+	if check.Status == rule.Failed {
+		observation.Types = []string{"finding"}
+	}
+
+	for targetIdx, target := range check.Targets {
+		observation.Subjects = append(observation.Subjects, oscalSubject{
+			SubjectUUID: oscalUUID(observationUUID, fmt.Sprint(targetIdx)),
+			Type:        "resource",
+			Title:       oscalTargetTitle(target),
+			Props:       oscalProps(target),
+		})
+	}
+	return observation
+}
+
+// oscalFindingStatus aggregates the statuses of the checks of a rule to an objective status.
+func oscalFindingStatus(checks []Check) (string, string) {
+	switch {
+	case slices.ContainsFunc(checks, func(c Check) bool { return c.Status == rule.Failed }):
+		return "not-satisfied", "fail"
+	case slices.ContainsFunc(checks, func(c Check) bool {
+		return c.Status != rule.Passed && c.Status != rule.Accepted && c.Status != rule.Skipped
+	}):
+		return "not-satisfied", "other"
+	case slices.ContainsFunc(checks, func(c Check) bool { return c.Status == rule.Passed }):
+		return "satisfied", "pass"
+	default:
+		return "satisfied", "other"
+	}
+}
+
+func oscalTargetTitle(target rule.Target) string {
+	attributes := make([]string, 0, len(target))
+	for _, key := range slices.Sorted(maps.Keys(target)) {
+		attributes = append(attributes, fmt.Sprintf("%s: %s", key, target[key]))
+	}
+	return strings.Join(attributes, ", ")
+}
+
+// oscalProps returns props sorted by name. Props with empty values are omitted
+// since OSCAL does not allow them.
+func oscalProps[M ~map[string]string](m M) []oscalProp {
+	return oscalPropsWithNamespace(m, oscalNamespace)
+}
+
+// oscalPropsWithNamespace returns props in the given namespace sorted by name.
+func oscalPropsWithNamespace[M ~map[string]string](m M, ns string) []oscalProp {
+	var props []oscalProp
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		if len(m[key]) == 0 {
+			continue
+		}
+		props = append(props, oscalProp{Name: key, NS: ns, Value: m[key]})
+	}
+	return props
+}
+
+// flattenMetadata converts the report metadata to string values.
+func flattenMetadata(metadata map[string]any) map[string]string {
+	flattened := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		flattened[key] = fmt.Sprint(value)
+	}
+	return flattened
+}
+
+// oscalUUID returns a deterministic UUID for the given names.
+func oscalUUID(names ...string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(oscalNamespace+"/"+strings.Join(names, "/"))).String()
+}
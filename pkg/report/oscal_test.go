@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("oscal", func() {
+	type prop struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	type assessmentResults struct {
+		AssessmentResults struct {
+			UUID     string `json:"uuid"`
+			Metadata struct {
+				LastModified time.Time `json:"last-modified"`
+				Version      string    `json:"version"`
+				OSCALVersion string    `json:"oscal-version"`
+				Props        []prop    `json:"props"`
+			} `json:"metadata"`
+			Results []struct {
+				ReviewedControls struct {
+					ControlSelections []struct {
+						IncludeControls []struct {
+							ControlID string `json:"control-id"`
+						} `json:"include-controls"`
+					} `json:"control-selections"`
+				} `json:"reviewed-controls"`
+				Observations []struct {
+					UUID        string `json:"uuid"`
+					Description string `json:"description"`
+					Subjects    []struct {
+						Type  string `json:"type"`
+						Title string `json:"title"`
+						Props []prop `json:"props"`
+					} `json:"subjects"`
+				} `json:"observations"`
+				Findings []struct {
+					Target struct {
+						TargetID string `json:"target-id"`
+						Status   struct {
+							State  string `json:"state"`
+							Reason string `json:"reason"`
+						} `json:"status"`
+					} `json:"target"`
+					RelatedObservations []struct {
+						ObservationUUID string `json:"observation-uuid"`
+					} `json:"related-observations"`
+				} `json:"findings"`
+			} `json:"results"`
+		} `json:"assessment-results"`
+	}
+
+	var (
+		reportTime time.Time
+		rep        *report.Report
+		render     = func(renderer *report.OSCALRenderer) assessmentResults {
+			buf := &bytes.Buffer{}
+			Expect(renderer.Render(buf, rep)).To(Succeed())
+
+			result := assessmentResults{}
+			Expect(json.Unmarshal(buf.Bytes(), &result)).To(Succeed())
+			return result
+		}
+	)
+
+	BeforeEach(func() {
+		reportTime = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		rep = &report.Report{
+			Time:        reportTime,
+			DikiVersion: "v1.0.0",
+			Metadata:    map[string]any{"foo": "bar"},
+			Providers: []report.Provider{
+				{
+					ID:   "provider-foo",
+					Name: "Provider Foo",
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:       "1",
+									Name:     "Rule 1",
+									Severity: rule.SeverityHigh,
+									Checks: []report.Check{
+										{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "foo", "namespace", "bar")}},
+										{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "baz")}},
+									},
+								},
+								{
+									ID:   "2",
+									Name: "Rule 2",
+									Checks: []report.Check{
+										{Status: rule.Passed, Message: "passed"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should map the report to OSCAL metadata", func() {
+		result := render(report.NewOSCALRenderer(nil))
+
+		Expect(result.AssessmentResults.UUID).ToNot(BeEmpty())
+		Expect(result.AssessmentResults.Metadata.LastModified).To(Equal(reportTime))
+		Expect(result.AssessmentResults.Metadata.Version).To(Equal("v1.0.0"))
+		Expect(result.AssessmentResults.Metadata.OSCALVersion).To(Equal("1.1.2"))
+		Expect(result.AssessmentResults.Metadata.Props).To(Equal([]prop{{Name: "foo", Value: "bar"}}))
+	})
+
+	It("should map checks to observations and rules to findings", func() {
+		result := render(report.NewOSCALRenderer(nil))
+
+		Expect(result.AssessmentResults.Results).To(HaveLen(1))
+		res := result.AssessmentResults.Results[0]
+
+		Expect(res.Observations).To(HaveLen(3))
+		Expect(res.Observations[0].Description).To(Equal("passed"))
+		Expect(res.Observations[0].Subjects).To(HaveLen(1))
+		Expect(res.Observations[0].Subjects[0].Type).To(Equal("resource"))
+		Expect(res.Observations[0].Subjects[0].Title).To(Equal("name: foo, namespace: bar"))
+		Expect(res.Observations[0].Subjects[0].Props).To(Equal([]prop{{Name: "name", Value: "foo"}, {Name: "namespace", Value: "bar"}}))
+		Expect(res.Observations[2].Subjects).To(BeEmpty())
+
+		Expect(res.Findings).To(HaveLen(2))
+		Expect(res.Findings[0].Target.TargetID).To(Equal("ruleset-foo-1"))
+		Expect(res.Findings[0].Target.Status.State).To(Equal("not-satisfied"))
+		Expect(res.Findings[0].Target.Status.Reason).To(Equal("fail"))
+		Expect(res.Findings[0].RelatedObservations).To(HaveLen(2))
+		Expect(res.Findings[0].RelatedObservations[1].ObservationUUID).To(Equal(res.Observations[1].UUID))
+		Expect(res.Findings[1].Target.TargetID).To(Equal("ruleset-foo-2"))
+		Expect(res.Findings[1].Target.Status.State).To(Equal("satisfied"))
+	})
+
+	It("should link rules to the mapped controls", func() {
+		result := render(report.NewOSCALRenderer(report.OSCALControlMapping{
+			"ruleset-foo": {"1": {"sc-8", "ac-3"}},
+			"ruleset-bar": {"2": {"cm-6"}},
+		}))
+
+		res := result.AssessmentResults.Results[0]
+		Expect(res.Findings).To(HaveLen(3))
+		Expect(res.Findings[0].Target.TargetID).To(Equal("sc-8"))
+		Expect(res.Findings[1].Target.TargetID).To(Equal("ac-3"))
+		Expect(res.Findings[2].Target.TargetID).To(Equal("ruleset-foo-2"))
+
+		controlIDs := []string{}
+		for _, control := range res.ReviewedControls.ControlSelections[0].IncludeControls {
+			controlIDs = append(controlIDs, control.ControlID)
+		}
+		Expect(controlIDs).To(Equal([]string{"ac-3", "ruleset-foo-2", "sc-8"}))
+	})
+
+	It("should not link rules to controls mapped for rules with the same id of other rulesets", func() {
+		result := render(report.NewOSCALRenderer(report.OSCALControlMapping{"ruleset-bar": {"1": {"sc-8"}}}))
+
+		res := result.AssessmentResults.Results[0]
+		Expect(res.Findings).To(HaveLen(2))
+		Expect(res.Findings[0].Target.TargetID).To(Equal("ruleset-foo-1"))
+	})
+
+	It("should return error for metadata keys which are not valid OSCAL tokens", func() {
+		rep.Metadata["cluster name"] = "foo"
+		rep.Providers[0].Metadata = map[string]string{"1st": "bar", "owner": "baz"}
+
+		Expect(report.NewOSCALRenderer(nil).Render(&bytes.Buffer{}, rep)).To(MatchError(`report metadata key "cluster name" is not a valid OSCAL token
+metadata key "1st" of provider with id provider-foo is not a valid OSCAL token`))
+	})
+
+	It("should return error for unsupported report types", func() {
+		Expect(report.NewOSCALRenderer(nil).Render(&bytes.Buffer{}, &report.MergedReport{})).To(MatchError("unsupported report type: *report.MergedReport"))
+	})
+})
@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	// PolicyReportKind is the kind of namespaced policy reports.
+	PolicyReportKind = "PolicyReport"
+	// ClusterPolicyReportKind is the kind of cluster scoped policy reports.
+	ClusterPolicyReportKind = "ClusterPolicyReport"
+	// LabelPolicyReportProvider is the label containing the provider ID of a policy report created by diki.
+	LabelPolicyReportProvider = "diki.gardener.cloud/provider"
+	// LabelPolicyReportRuleset is the label containing the ruleset ID of a policy report created by diki.
+	LabelPolicyReportRuleset = "diki.gardener.cloud/ruleset"
+	// LabelPolicyReportRulesetVersion is the label containing the ruleset version of a policy report created by diki.
+	LabelPolicyReportRulesetVersion = "diki.gardener.cloud/ruleset-version"
+
+	policyReportSource     = "diki"
+	labelManagedBy         = "app.kubernetes.io/managed-by"
+	policyReportFieldOwner = "diki"
+)
+
+var (
+	// PolicyReportGroupVersion is the group version of the wg-policy policy reports.
+	PolicyReportGroupVersion = schema.GroupVersion{Group: "wgpolicyk8s.io", Version: "v1alpha2"}
+
+	invalidNameCharsRegex = regexp.MustCompile(`[^a-z0-9.-]+`)
+)
+
+// PolicyReport is a wg-policy PolicyReport or ClusterPolicyReport.
+type PolicyReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Summary           PolicyReportSummary  `json:"summary"`
+	Results           []PolicyReportResult `json:"results,omitempty"`
+}
+
+// PolicyReportSummary contains the number of results per result status.
+type PolicyReportSummary struct {
+	Pass  int `json:"pass"`
+	Fail  int `json:"fail"`
+	Warn  int `json:"warn"`
+	Error int `json:"error"`
+	Skip  int `json:"skip"`
+}
+
+// PolicyReportResult is the result of a single check target.
+type PolicyReportResult struct {
+	Source     string                   `json:"source"`
+	Policy     string                   `json:"policy"`
+	Rule       string                   `json:"rule,omitempty"`
+	Category   string                   `json:"category,omitempty"`
+	Severity   string                   `json:"severity,omitempty"`
+	Timestamp  metav1.Timestamp         `json:"timestamp"`
+	Result     string                   `json:"result"`
+	Scored     bool                     `json:"scored"`
+	Message    string                   `json:"message,omitempty"`
+	Resources  []corev1.ObjectReference `json:"resources,omitempty"`
+	Properties map[string]string        `json:"properties,omitempty"`
+}
+
+// PolicyReportsFromReport converts a Diki report to policy reports. Check targets containing
+// the kind, name and namespace of a Kubernetes object are reported in a namespaced PolicyReport.
+// All other check targets are reported in a ClusterPolicyReport.
+// A separate policy report is created for every provider, ruleset and namespace.
+func PolicyReportsFromReport(report *Report) []PolicyReport {
+	var policyReports []PolicyReport
+	for _, provider := range report.Providers {
+		for _, ruleset := range provider.Rulesets {
+			resultsByNamespace := map[string][]PolicyReportResult{}
+			for _, r := range ruleset.Rules {
+				for _, check := range r.Checks {
+					targets := check.Targets
+					if len(targets) == 0 {
+						targets = []rule.Target{nil}
+					}
+
+					for _, target := range targets {
+						namespace, result := policyReportResult(report, ruleset, r, check, target)
+						resultsByNamespace[namespace] = append(resultsByNamespace[namespace], result)
+					}
+				}
+			}
+
+			for _, namespace := range sortedKeys(resultsByNamespace) {
+				policyReport := PolicyReport{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: PolicyReportGroupVersion.String(),
+						Kind:       PolicyReportKind,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      policyReportName(provider.ID, ruleset.ID, ruleset.Version),
+						Namespace: namespace,
+						Labels: map[string]string{
+							labelManagedBy:                  policyReportSource,
+							LabelPolicyReportProvider:       policyReportLabelValue(provider.ID),
+							LabelPolicyReportRuleset:        policyReportLabelValue(ruleset.ID),
+							LabelPolicyReportRulesetVersion: policyReportLabelValue(ruleset.Version),
+						},
+					},
+					Results: resultsByNamespace[namespace],
+				}
+				if len(namespace) == 0 {
+					policyReport.Kind = ClusterPolicyReportKind
+				}
+				for _, result := range policyReport.Results {
+					policyReport.Summary.add(result.Result)
+				}
+				policyReports = append(policyReports, policyReport)
+			}
+		}
+	}
+	return policyReports
+}
+
+func policyReportResult(report *Report, ruleset Ruleset, r Rule, check Check, target rule.Target) (string, PolicyReportResult) {
+	result := PolicyReportResult{
+		Source:    policyReportSource,
+		Policy:    r.ID,
+		Rule:      r.Name,
+		Category:  fmt.Sprintf("%s %s", ruleset.Name, ruleset.Version),
+		Severity:  strings.ToLower(string(r.Severity)),
+		Timestamp: metav1.Timestamp{Seconds: report.Time.Unix(), Nanos: int32(report.Time.Nanosecond())}, // #nosec G115 -- nanoseconds are always in the range of int32
+		Result:    policyReportResultStatus(check.Status),
+		Scored:    true,
+		Message:   check.Message,
+	}
+
+	properties := maps.Clone(target)
+	kind, name, namespace := properties["kind"], properties["name"], properties["namespace"]
+	if len(kind) == 0 || len(name) == 0 {
+		namespace = ""
+	} else {
+		result.Resources = []corev1.ObjectReference{{Kind: kind, Name: name, Namespace: namespace}}
+		delete(properties, "kind")
+		delete(properties, "name")
+		delete(properties, "namespace")
+	}
+	if len(properties) > 0 {
+		result.Properties = properties
+	}
+	return namespace, result
+}
+
+func policyReportResultStatus(status rule.Status) string {
+	switch status {
+	case rule.Passed:
+		return "pass"
+	case rule.Failed:
+		return "fail"
+	case rule.Warning:
+		return "warn"
+	case rule.Errored:
+		return "error"
+	default:
+		return "skip"
+	}
+}
+
+func (s *PolicyReportSummary) add(result string) {
+	switch result {
+	case "pass":
+		s.Pass++
+	case "fail":
+		s.Fail++
+	case "warn":
+		s.Warn++
+	case "error":
+		s.Error++
+	default:
+		s.Skip++
+	}
+}
+
+func policyReportName(providerID, rulesetID, rulesetVersion string) string {
+	name := strings.ToLower(fmt.Sprintf("diki-%s-%s-%s", providerID, rulesetID, rulesetVersion))
+	return strings.Trim(invalidNameCharsRegex.ReplaceAllString(name, "-"), "-.")
+}
+
+func policyReportLabelValue(value string) string {
+	value = invalidNameCharsRegex.ReplaceAllString(strings.ToLower(value), "-")
+	return strings.Trim(value[:min(len(value), 63)], "-.")
+}
+
+// PolicyReportRenderer renders Diki reports as wg-policy policy reports in yaml format.
+type PolicyReportRenderer struct{}
+
+// NewPolicyReportRenderer creates a PolicyReportRenderer.
+func NewPolicyReportRenderer() *PolicyReportRenderer {
+	return &PolicyReportRenderer{}
+}
+
+// Render writes the policy reports of a Diki report as multi document yaml into the passed writer.
+func (r *PolicyReportRenderer) Render(w io.Writer, report any) error {
+	rep, ok := report.(*Report)
+	if !ok {
+		return fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	for i, policyReport := range PolicyReportsFromReport(rep) {
+		data, err := yaml.Marshal(policyReport)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyPolicyReports creates or updates the given policy reports in the cluster.
+// Policy reports of the same providers and rulesets that were previously created by diki
+// and are not part of the given ones are deleted, e.g. for namespaces without results.
+func ApplyPolicyReports(ctx context.Context, c client.Client, policyReports []PolicyReport) error {
+	var (
+		applied   = map[string]struct{}{}
+		selectors = map[string]client.MatchingLabels{}
+		err       error
+	)
+	for _, policyReport := range policyReports {
+		if applyErr := applyPolicyReport(ctx, c, policyReport); applyErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to apply %s %s: %w", policyReport.Kind, types.NamespacedName{Namespace: policyReport.Namespace, Name: policyReport.Name}, applyErr))
+			continue
+		}
+
+		applied[policyReportKey(policyReport.Kind, policyReport.Namespace, policyReport.Name)] = struct{}{}
+		selector := client.MatchingLabels{labelManagedBy: policyReportSource}
+		for _, label := range []string{LabelPolicyReportProvider, LabelPolicyReportRuleset, LabelPolicyReportRulesetVersion} {
+			selector[label] = policyReport.Labels[label]
+		}
+		selectors[policyReport.Name] = selector
+	}
+
+	for _, name := range sortedKeys(selectors) {
+		for _, kind := range []string{PolicyReportKind, ClusterPolicyReportKind} {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(PolicyReportGroupVersion.WithKind(kind + "List"))
+			if listErr := c.List(ctx, list, selectors[name]); listErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to list %s objects: %w", kind, listErr))
+				continue
+			}
+
+			for _, item := range list.Items {
+				if _, ok := applied[policyReportKey(kind, item.GetNamespace(), item.GetName())]; ok {
+					continue
+				}
+				if deleteErr := c.Delete(ctx, &item); client.IgnoreNotFound(deleteErr) != nil {
+					err = errors.Join(err, fmt.Errorf("failed to delete outdated %s %s: %w", kind, client.ObjectKeyFromObject(&item), deleteErr))
+				}
+			}
+		}
+	}
+	return err
+}
+
+func applyPolicyReport(ctx context.Context, c client.Client, policyReport PolicyReport) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&policyReport)
+	if err != nil {
+		return err
+	}
+	obj := &unstructured.Unstructured{Object: content}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		return c.Create(ctx, obj, client.FieldOwner(policyReportFieldOwner))
+	}
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	return c.Update(ctx, obj, client.FieldOwner(policyReportFieldOwner))
+}
+
+func policyReportKey(kind, namespace, name string) string {
+	return strings.Join([]string{kind, namespace, name}, "/")
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("policyreport", func() {
+	var rep *report.Report
+
+	BeforeEach(func() {
+		rep = &report.Report{
+			Time:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DikiVersion: "1",
+			Providers: []report.Provider{
+				{
+					ID:   "provider-foo",
+					Name: "Provider Foo",
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:       "1",
+									Name:     "Rule 1",
+									Severity: rule.SeverityHigh,
+									Checks: []report.Check{
+										{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("kind", "Pod", "name", "foo", "namespace", "bar", "container", "baz")}},
+										{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("kind", "Node", "name", "foo")}},
+									},
+								},
+								{
+									ID:   "2",
+									Name: "Rule 2",
+									Checks: []report.Check{
+										{Status: rule.Errored, Message: "errored"},
+										{Status: rule.Accepted, Message: "accepted", Targets: []rule.Target{rule.NewTarget("details", "foo")}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#PolicyReportsFromReport", func() {
+		It("should create a policy report per namespace", func() {
+			policyReports := report.PolicyReportsFromReport(rep)
+			Expect(policyReports).To(HaveLen(2))
+
+			clusterReport := policyReports[0]
+			Expect(clusterReport.Kind).To(Equal(report.ClusterPolicyReportKind))
+			Expect(clusterReport.APIVersion).To(Equal("wgpolicyk8s.io/v1alpha2"))
+			Expect(clusterReport.Name).To(Equal("diki-provider-foo-ruleset-foo-v1"))
+			Expect(clusterReport.Namespace).To(BeEmpty())
+			Expect(clusterReport.Labels).To(Equal(map[string]string{
+				"app.kubernetes.io/managed-by":        "diki",
+				"diki.gardener.cloud/provider":        "provider-foo",
+				"diki.gardener.cloud/ruleset":         "ruleset-foo",
+				"diki.gardener.cloud/ruleset-version": "v1",
+			}))
+			Expect(clusterReport.Summary).To(Equal(report.PolicyReportSummary{Fail: 1, Error: 1, Skip: 1}))
+			Expect(clusterReport.Results).To(HaveLen(3))
+			Expect(clusterReport.Results[0].Policy).To(Equal("1"))
+			Expect(clusterReport.Results[0].Rule).To(Equal("Rule 1"))
+			Expect(clusterReport.Results[0].Severity).To(Equal("high"))
+			Expect(clusterReport.Results[0].Category).To(Equal("Ruleset Foo v1"))
+			Expect(clusterReport.Results[0].Result).To(Equal("fail"))
+			Expect(clusterReport.Results[0].Resources).To(Equal([]corev1.ObjectReference{{Kind: "Node", Name: "foo"}}))
+			Expect(clusterReport.Results[1].Resources).To(BeEmpty())
+			Expect(clusterReport.Results[1].Result).To(Equal("error"))
+			Expect(clusterReport.Results[2].Result).To(Equal("skip"))
+			Expect(clusterReport.Results[2].Properties).To(Equal(map[string]string{"details": "foo"}))
+
+			namespacedReport := policyReports[1]
+			Expect(namespacedReport.Kind).To(Equal(report.PolicyReportKind))
+			Expect(namespacedReport.Namespace).To(Equal("bar"))
+			Expect(namespacedReport.Summary).To(Equal(report.PolicyReportSummary{Pass: 1}))
+			Expect(namespacedReport.Results).To(HaveLen(1))
+			Expect(namespacedReport.Results[0].Resources).To(Equal([]corev1.ObjectReference{{Kind: "Pod", Name: "foo", Namespace: "bar"}}))
+			Expect(namespacedReport.Results[0].Properties).To(Equal(map[string]string{"container": "baz"}))
+			Expect(namespacedReport.Results[0].Timestamp.Seconds).To(Equal(rep.Time.Unix()))
+		})
+	})
+
+	Describe("#Render", func() {
+		It("should render the policy reports as multi document yaml", func() {
+			buf := &bytes.Buffer{}
+			Expect(report.NewPolicyReportRenderer().Render(buf, rep)).To(Succeed())
+
+			documents := strings.Split(buf.String(), "---\n")
+			Expect(documents).To(HaveLen(2))
+
+			policyReport := report.PolicyReport{}
+			Expect(yaml.Unmarshal([]byte(documents[1]), &policyReport)).To(Succeed())
+			Expect(policyReport).To(Equal(report.PolicyReportsFromReport(rep)[1]))
+		})
+
+		It("should return error for unsupported report types", func() {
+			Expect(report.NewPolicyReportRenderer().Render(&bytes.Buffer{}, &report.MergedReport{})).To(MatchError("unsupported report type: *report.MergedReport"))
+		})
+	})
+
+	Describe("#ApplyPolicyReports", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+
+			getPolicyReport = func(kind, namespace, name string) (*unstructured.Unstructured, error) {
+				obj := &unstructured.Unstructured{}
+				obj.SetGroupVersionKind(report.PolicyReportGroupVersion.WithKind(kind))
+				return obj, fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj)
+			}
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().Build()
+		})
+
+		It("should create and update policy reports", func() {
+			Expect(report.ApplyPolicyReports(ctx, fakeClient, report.PolicyReportsFromReport(rep))).To(Succeed())
+
+			obj, err := getPolicyReport(report.PolicyReportKind, "bar", "diki-provider-foo-ruleset-foo-v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(obj.Object).To(HaveKeyWithValue("summary", HaveKeyWithValue("pass", BeNumerically("==", 1))))
+
+			rep.Providers[0].Rulesets[0].Rules[0].Checks[0].Status = rule.Failed
+			Expect(report.ApplyPolicyReports(ctx, fakeClient, report.PolicyReportsFromReport(rep))).To(Succeed())
+
+			obj, err = getPolicyReport(report.PolicyReportKind, "bar", "diki-provider-foo-ruleset-foo-v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(obj.Object).To(HaveKeyWithValue("summary", HaveKeyWithValue("fail", BeNumerically("==", 1))))
+
+			_, err = getPolicyReport(report.ClusterPolicyReportKind, "", "diki-provider-foo-ruleset-foo-v1")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should delete outdated policy reports", func() {
+			Expect(report.ApplyPolicyReports(ctx, fakeClient, report.PolicyReportsFromReport(rep))).To(Succeed())
+
+			rep.Providers[0].Rulesets[0].Rules[0].Checks[0].Targets[0]["namespace"] = "foo"
+			Expect(report.ApplyPolicyReports(ctx, fakeClient, report.PolicyReportsFromReport(rep))).To(Succeed())
+
+			_, err := getPolicyReport(report.PolicyReportKind, "bar", "diki-provider-foo-ruleset-foo-v1")
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+			_, err = getPolicyReport(report.PolicyReportKind, "foo", "diki-provider-foo-ruleset-foo-v1")
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"io"
 	"maps"
 	"slices"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -25,6 +26,10 @@ const (
 	tmplMergedReportPath     = "templates/html/merged_report.html"
 	tmplDifferenceReportName = "difference_report"
 	tmplDifferenceReportPath = "templates/html/difference_report.html"
+	tmplTrendReportName      = "trend_report"
+	tmplTrendReportPath      = "templates/html/trend_report.html"
+	tmplFrameworkReportName  = "framework_report"
+	tmplFrameworkReportPath  = "templates/html/framework_report.html"
 	tmplStylesPath           = "templates/html/_styles.tpl"
 )
 
@@ -33,6 +38,11 @@ var (
 	files embed.FS
 )
 
+// Renderer renders Diki reports into a writer.
+type Renderer interface {
+	Render(w io.Writer, report any) error
+}
+
 // HTMLRenderer renders Diki reports in html format.
 type HTMLRenderer struct {
 	templates map[string]*template.Template
@@ -43,6 +53,9 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 	convTimeFunc := func(time time.Time) string {
 		return time.Format("01-02-2006")
 	}
+	convDateTimeFunc := func(time time.Time) string {
+		return time.Format("01-02-2006 15:04:05")
+	}
 	add := func(a, b int) int {
 		return a + b
 	}
@@ -60,15 +73,18 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 	templates := make(map[string]*template.Template)
 
 	parsedReport, err := template.New(tmplReportName+".html").Funcs(template.FuncMap{
-		"getStatuses":        rule.Statuses,
-		"statusIcon":         rule.StatusIcon,
-		"statusDescription":  rule.StatusDescription,
-		"time":               convTimeFunc,
-		"yamlFormat":         yamlFormat,
-		"rulesetSummaryText": rulesetSummaryText,
-		"rulesWithStatus":    rulesWithStatus,
-		"sortedMapKeys":      sortedKeys[string],
-		"ruleTitle":          ruleTitle,
+		"getStatuses":         rule.Statuses,
+		"statusIcon":          rule.StatusIcon,
+		"statusDescription":   rule.StatusDescription,
+		"time":                convTimeFunc,
+		"yamlFormat":          yamlFormat,
+		"rulesetSummaryText":  rulesetSummaryText,
+		"rulesWithStatus":     rulesWithStatus,
+		"sortedMapKeys":       sortedKeys[string],
+		"ruleTitle":           ruleTitle,
+		"complianceScoreText": complianceScoreText,
+		"targetSummaryText":   targetSummaryText,
+		"severitySummaryText": severitySummaryText,
 	}).ParseFS(files, tmplReportPath, tmplStylesPath)
 	if err != nil {
 		return nil, err
@@ -109,6 +125,29 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 	}
 	templates[tmplDifferenceReportName] = parsedDifferenceReport
 
+	parsedTrendReport, err := template.New(tmplTrendReportName+".html").Funcs(template.FuncMap{
+		"getStatuses":   rule.Statuses,
+		"getSeverities": rule.Severities,
+		"statusIcon":    rule.StatusIcon,
+		"dateTime":      convDateTimeFunc,
+		"join":          strings.Join,
+	}).ParseFS(files, tmplTrendReportPath, tmplStylesPath)
+	if err != nil {
+		return nil, err
+	}
+	templates[tmplTrendReportName] = parsedTrendReport
+
+	parsedFrameworkReport, err := template.New(tmplFrameworkReportName+".html").Funcs(template.FuncMap{
+		"statusIcon":         rule.StatusIcon,
+		"time":               convTimeFunc,
+		"ruleTitle":          ruleTitle,
+		"controlSummaryText": controlSummaryText,
+	}).ParseFS(files, tmplFrameworkReportPath, tmplStylesPath)
+	if err != nil {
+		return nil, err
+	}
+	templates[tmplFrameworkReportName] = parsedFrameworkReport
+
 	return &HTMLRenderer{
 		templates: templates,
 	}, nil
@@ -123,6 +162,10 @@ func (r *HTMLRenderer) Render(w io.Writer, report any) error {
 		return r.templates[tmplMergedReportName].Execute(w, rep)
 	case *DifferenceReportsWrapper:
 		return r.templates[tmplDifferenceReportName].Execute(w, rep)
+	case *TrendReport:
+		return r.templates[tmplTrendReportName].Execute(w, rep)
+	case *FrameworkReport:
+		return r.templates[tmplFrameworkReportName].Execute(w, rep)
 	default:
 		return fmt.Errorf("unsupported report type: %T", report)
 	}
@@ -6,11 +6,14 @@ package report
 
 import (
 	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"maps"
 	"os"
 	"slices"
+	"strings"
 	"time"
 
 	"k8s.io/component-base/version"
@@ -27,7 +30,10 @@ type Report struct {
 	MinStatus   rule.Status    `json:"minStatus,omitempty"`
 	DikiVersion string         `json:"dikiVersion"`
 	Metadata    map[string]any `json:"metadata,omitempty"`
-	Providers   []Provider     `json:"providers"`
+	// Baseline is set if the report was compared to a baseline report.
+	Baseline  *Baseline  `json:"baseline,omitempty"`
+	Summary   *Summary   `json:"summary,omitempty"`
+	Providers []Provider `json:"providers"`
 }
 
 // Provider contains information about a known provider
@@ -36,15 +42,30 @@ type Provider struct {
 	ID       string            `json:"id"`
 	Name     string            `json:"name"`
 	Metadata map[string]string `json:"metadata,omitempty"`
+	Summary  *Summary          `json:"summary,omitempty"`
 	Rulesets []Ruleset         `json:"rulesets"`
 }
 
 // Ruleset contains information about a rule set and its rules.
 type Ruleset struct {
-	ID      string `json:"id"`
-	Name    string `json:"name"`
-	Version string `json:"version"`
-	Rules   []Rule `json:"rules"`
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Version    string      `json:"version"`
+	Summary    *Summary    `json:"summary,omitempty"`
+	Rules      []Rule      `json:"rules"`
+	Exceptions []Exception `json:"exceptions,omitempty"`
+}
+
+// Exception contains information about an exception configured for a ruleset.
+type Exception struct {
+	RuleID        string                 `json:"ruleID"`
+	Targets       []rule.Target          `json:"targets,omitempty"`
+	Justification string                 `json:"justification"`
+	Owner         string                 `json:"owner,omitempty"`
+	Ticket        string                 `json:"ticket,omitempty"`
+	ExpiresAt     time.Time              `json:"expiresAt"`
+	State         ruleset.ExceptionState `json:"state"`
+	Matches       int                    `json:"matches"`
 }
 
 // Rule contains information about a ran rule.
@@ -52,7 +73,31 @@ type Rule struct {
 	ID       string             `json:"id"`
 	Name     string             `json:"name"`
 	Severity rule.SeverityLevel `json:"severity,omitempty"`
-	Checks   []Check            `json:"checks"`
+	Retry    *Retry             `json:"retry,omitempty"`
+	// Documentation contains the documentation of the rule if the ruleset provides it.
+	Documentation *Documentation `json:"documentation,omitempty"`
+	Checks        []Check        `json:"checks"`
+}
+
+// Retry contains information about a rule that was run more than once.
+type Retry struct {
+	Attempts int      `json:"attempts"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// Documentation describes what a rule checks, why it matters and how to remediate findings.
+type Documentation struct {
+	Description string      `json:"description"`
+	Rationale   string      `json:"rationale,omitempty"`
+	Remediation string      `json:"remediation,omitempty"`
+	References  []Reference `json:"references,omitempty"`
+}
+
+// Reference is a reference of a rule to an external security standard.
+type Reference struct {
+	Type rule.ReferenceType `json:"type"`
+	ID   string             `json:"id"`
+	URL  string             `json:"url,omitempty"`
 }
 
 // Check is the result of a single Rule check.
@@ -60,12 +105,18 @@ type Check struct {
 	Status  rule.Status   `json:"status"`
 	Message string        `json:"message"`
 	Targets []rule.Target `json:"targets,omitempty"`
+	// Fingerprints contains the fingerprint of every target at the same index.
+	// Checks without targets have a single fingerprint.
+	Fingerprints []string `json:"fingerprints,omitempty"`
+	// Baseline is true if the targets of the check are already contained in the baseline report.
+	Baseline bool `json:"baseline,omitempty"`
 }
 
 // ReportOptions are options that can be applied to a Report.
 type ReportOptions struct {
-	MinStatus rule.Status
-	Metadata  map[string]any
+	MinStatus    rule.Status
+	Metadata     map[string]any
+	ScoreWeights ScoreWeights
 }
 
 // ReportOption defines a single option that can be applied to a Report.
@@ -99,7 +150,6 @@ func FromProviderResults(results []provider.ProviderResult, options ...ReportOpt
 	}
 	report := &Report{
 		Time:        time.Now().UTC(),
-		MinStatus:   opts.MinStatus,
 		DikiVersion: version.Get().GitVersion,
 		Metadata:    opts.Metadata,
 		Providers:   make([]Provider, 0, len(results)),
@@ -109,13 +159,111 @@ func FromProviderResults(results []provider.ProviderResult, options ...ReportOpt
 			ID:       providerResult.ProviderID,
 			Name:     providerResult.ProviderName,
 			Metadata: providerResult.Metadata,
-			Rulesets: getRulesets(providerResult.RulesetResults, opts),
+			Rulesets: getRulesets(providerResult.RulesetResults),
 		}
 		report.Providers = append(report.Providers, p)
 	}
+	// the summary is calculated before checks are filtered by the minimal status
+	report.SetSummary(opts.ScoreWeights)
+	if len(opts.MinStatus) > 0 {
+		report.SetMinStatus(opts.MinStatus)
+	}
+	report.SetFingerprints()
 	return report
 }
 
+// Fingerprint returns a deterministic fingerprint of a check target. The fingerprint does not depend
+// on the check status, so that the same finding can be tracked across reports. The message key
+// distinguishes different findings of a rule on the same target and should be empty otherwise.
+func Fingerprint(providerID, rulesetID, rulesetVersion, ruleID, messageKey string, target rule.Target) string {
+	hash := sha256.New()
+	for _, value := range []string{providerID, rulesetID, rulesetVersion, ruleID, messageKey, canonicalTarget(target)} {
+		// the values are separated by a null byte to avoid ambiguous concatenations
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil)[:16])
+}
+
+// MessageKey returns the normalized check message, which is used as message key
+// for targets that are reported by more than one check of a rule.
+func MessageKey(message string) string {
+	return strings.ToLower(strings.Join(strings.Fields(message), " "))
+}
+
+// canonicalTarget returns the target attributes sorted by key.
+func canonicalTarget(target rule.Target) string {
+	var sb strings.Builder
+	for _, key := range sortedKeys(target) {
+		fmt.Fprintf(&sb, "%q=%q;", key, target[key])
+	}
+	return sb.String()
+}
+
+// SetFingerprints sets the fingerprints of all checks in the report.
+// It can be used for reports created by Diki versions without fingerprints.
+// Check messages are only part of the fingerprints of targets which are reported by more than one check
+// of a rule, since rules usually word the messages of the same finding differently per status.
+func (r *Report) SetFingerprints() {
+	for _, provider := range r.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, rule := range ruleset.Rules {
+				targetChecks := map[string]int{}
+				for _, check := range rule.Checks {
+					for _, target := range checkTargets(check) {
+						targetChecks[canonicalTarget(target)]++
+					}
+				}
+				for checkIdx, check := range rule.Checks {
+					fingerprints := make([]string, 0, max(len(check.Targets), 1))
+					for _, target := range checkTargets(check) {
+						var messageKey string
+						if targetChecks[canonicalTarget(target)] > 1 {
+							messageKey = MessageKey(check.Message)
+						}
+						fingerprints = append(fingerprints, Fingerprint(provider.ID, ruleset.ID, ruleset.Version, rule.ID, messageKey, target))
+					}
+					rule.Checks[checkIdx].Fingerprints = fingerprints
+				}
+			}
+		}
+	}
+}
+
+// WithTargets returns a copy of the provider which only contains the check targets accepted by the filter.
+// Checks without targets or without accepted targets are omitted.
+func (p Provider) WithTargets(filter func(target rule.Target) bool) Provider {
+	rulesets := make([]Ruleset, 0, len(p.Rulesets))
+	for _, rs := range p.Rulesets {
+		rules := make([]Rule, 0, len(rs.Rules))
+		for _, r := range rs.Rules {
+			checks := make([]Check, 0, len(r.Checks))
+			for _, check := range r.Checks {
+				filtered := check
+				filtered.Targets, filtered.Fingerprints = nil, nil
+				for i, target := range check.Targets {
+					if !filter(target) {
+						continue
+					}
+					filtered.Targets = append(filtered.Targets, target)
+					if i < len(check.Fingerprints) {
+						filtered.Fingerprints = append(filtered.Fingerprints, check.Fingerprints[i])
+					}
+				}
+				if len(filtered.Targets) > 0 {
+					checks = append(checks, filtered)
+				}
+			}
+			r.Checks = checks
+			rules = append(rules, r)
+		}
+		rs.Rules = rules
+		rulesets = append(rulesets, rs)
+	}
+	p.Rulesets = rulesets
+	return p
+}
+
 // WriteToFile writes a Diki report to a file.
 func (r *Report) WriteToFile(filePath string) error {
 	data, err := json.Marshal(r)
@@ -145,6 +293,37 @@ func (r *Report) SetMinStatus(minStatus rule.Status) {
 	}
 }
 
+// FailCondition describes which checks of a report are considered findings.
+type FailCondition struct {
+	// Statuses are the check statuses that are considered findings.
+	Statuses []rule.Status
+	// MinSeverity is the minimal severity of rules whose checks are considered findings.
+	// If empty the checks of all rules are considered regardless of their severity.
+	MinSeverity rule.SeverityLevel
+}
+
+// NumFindings returns the number of check targets in the report that match the given fail condition.
+// Checks without targets are counted once. Baseline findings are not counted.
+func (r *Report) NumFindings(failCondition FailCondition) int {
+	var numFindings int
+	for _, provider := range r.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, rule := range ruleset.Rules {
+				if len(failCondition.MinSeverity) > 0 && rule.Severity.Less(failCondition.MinSeverity) {
+					continue
+				}
+				for _, check := range rule.Checks {
+					if check.Baseline || !slices.Contains(failCondition.Statuses, check.Status) {
+						continue
+					}
+					numFindings += max(len(check.Targets), 1)
+				}
+			}
+		}
+	}
+	return numFindings
+}
+
 // rulesetSummaryText returns a summary string with the number of rules with results per status.
 func rulesetSummaryText(ruleset *Ruleset) string {
 	statuses := rule.Statuses()
@@ -186,7 +365,7 @@ func numOfRulesWithStatus(ruleset *Ruleset, status rule.Status) int {
 func rulesWithStatus(ruleset *Ruleset, status rule.Status) []Rule {
 	var result []Rule
 	for _, rule := range ruleset.Rules {
-		ruleWithStatus := Rule{ID: rule.ID, Name: rule.Name, Severity: rule.Severity}
+		ruleWithStatus := Rule{ID: rule.ID, Name: rule.Name, Severity: rule.Severity, Retry: rule.Retry, Documentation: rule.Documentation}
 		for _, check := range rule.Checks {
 			if check.Status == status {
 				ruleWithStatus.Checks = append(ruleWithStatus.Checks, check)
@@ -203,40 +382,70 @@ func rulesWithStatus(ruleset *Ruleset, status rule.Status) []Rule {
 	return result
 }
 
-func getRulesets(rulesetResults []ruleset.RulesetResult, opts *ReportOptions) []Ruleset {
+func getRulesets(rulesetResults []ruleset.RulesetResult) []Ruleset {
 	rulesets := make([]Ruleset, 0, len(rulesetResults))
 	for _, rulesetResult := range rulesetResults {
 		rs := Ruleset{
 			ID:      rulesetResult.RulesetID,
 			Name:    rulesetResult.RulesetName,
 			Version: rulesetResult.RulesetVersion,
-			Rules:   getRules(rulesetResult.RuleResults, opts),
+			Rules:   getRules(rulesetResult.RuleResults),
+		}
+		for _, exceptionResult := range rulesetResult.Exceptions {
+			rs.Exceptions = append(rs.Exceptions, Exception{
+				RuleID:        exceptionResult.RuleID,
+				Targets:       exceptionResult.Targets,
+				Justification: exceptionResult.Justification,
+				Owner:         exceptionResult.Owner,
+				Ticket:        exceptionResult.Ticket,
+				ExpiresAt:     exceptionResult.ExpiresAt,
+				State:         exceptionResult.State,
+				Matches:       exceptionResult.Matches,
+			})
 		}
 		rulesets = append(rulesets, rs)
 	}
 	return rulesets
 }
 
-func getRules(ruleResults []rule.RuleResult, opts *ReportOptions) []Rule {
+func getRules(ruleResults []rule.RuleResult) []Rule {
 	rules := make([]Rule, 0, len(ruleResults))
 	for _, ruleResult := range ruleResults {
 		r := Rule{
 			ID:       ruleResult.RuleID,
 			Name:     ruleResult.RuleName,
 			Severity: ruleResult.Severity,
-			Checks:   getChecks(ruleResult.CheckResults, opts),
+			Checks:   getChecks(ruleResult.CheckResults),
+		}
+		if ruleResult.Retry != nil {
+			r.Retry = &Retry{
+				Attempts: ruleResult.Retry.Attempts,
+				Errors:   ruleResult.Retry.Errors,
+			}
+		}
+		if ruleResult.Documentation != nil {
+			r.Documentation = getDocumentation(*ruleResult.Documentation)
 		}
 		rules = append(rules, r)
 	}
 	return rules
 }
 
-func getChecks(checkResults []rule.CheckResult, opts *ReportOptions) []Check {
+func getDocumentation(documentation rule.Documentation) *Documentation {
+	doc := &Documentation{
+		Description: documentation.Description,
+		Rationale:   documentation.Rationale,
+		Remediation: documentation.Remediation,
+	}
+	for _, ref := range documentation.References {
+		doc.References = append(doc.References, Reference{Type: ref.Type, ID: ref.ID, URL: ref.URL})
+	}
+	return doc
+}
+
+func getChecks(checkResults []rule.CheckResult) []Check {
 	groupedChecks := map[string]*Check{}
 	for _, checkResult := range checkResults {
-		if opts.MinStatus != "" && checkResult.Status.Less(opts.MinStatus) {
-			continue
-		}
 		key := fmt.Sprintf("%s--%s", checkResult.Status, checkResult.Message)
 		check, ok := groupedChecks[key]
 		if !ok {
@@ -256,7 +465,17 @@ func getChecks(checkResults []rule.CheckResult, opts *ReportOptions) []Check {
 
 	checks := make([]Check, 0, len(groupedChecks))
 	for _, check := range groupedChecks {
+		slices.SortFunc(check.Targets, func(a, b rule.Target) int {
+			return cmp.Compare(canonicalTarget(a), canonicalTarget(b))
+		})
 		checks = append(checks, *check)
 	}
+	// sort checks by status and message since map iteration order is random
+	slices.SortFunc(checks, func(a, b Check) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(rule.Statuses(), a.Status), slices.Index(rule.Statuses(), b.Status)),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
 	return checks
 }
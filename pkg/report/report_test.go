@@ -10,8 +10,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/report"
 	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
 )
 
 var _ = Describe("report", func() {
@@ -170,6 +172,215 @@ var _ = Describe("report", func() {
 			simpleReport.SetMinStatus(rule.Passed)
 			Expect(simpleReport).To(Equal(expectedReport))
 		})
+
+		DescribeTable("#NumFindings",
+			func(failCondition report.FailCondition, expectedFindings int) {
+				Expect(simpleReport.NumFindings(failCondition)).To(Equal(expectedFindings))
+			},
+			Entry("should count checks with matching status", report.FailCondition{Statuses: []rule.Status{rule.Failed}}, 3),
+			Entry("should count checks with any of the statuses", report.FailCondition{Statuses: []rule.Status{rule.Failed, rule.Accepted}}, 6),
+			Entry("should count only checks of rules with at least the min severity", report.FailCondition{Statuses: []rule.Status{rule.Accepted}, MinSeverity: rule.SeverityMedium}, 1),
+			Entry("should not count checks when statuses are not set", report.FailCondition{MinSeverity: rule.SeverityLow}, 0),
+		)
+
+		It("should count every target of a matching check", func() {
+			simpleReport.Providers[0].Rulesets[0].Rules[0].Checks[1].Targets = []rule.Target{rule.NewTarget("name", "foo"), rule.NewTarget("name", "bar")}
+			Expect(simpleReport.NumFindings(report.FailCondition{Statuses: []rule.Status{rule.Failed}, MinSeverity: rule.SeverityHigh})).To(Equal(2))
+		})
+	})
+
+	Describe("#FromProviderResults", func() {
+		var providerResults []provider.ProviderResult
+
+		BeforeEach(func() {
+			providerResults = []provider.ProviderResult{
+				{
+					ProviderID:   "provider-foo",
+					ProviderName: "Provider Foo",
+					RulesetResults: []ruleset.RulesetResult{
+						{
+							RulesetID:      "ruleset-foo",
+							RulesetName:    "Ruleset Foo",
+							RulesetVersion: "v1",
+							RuleResults: []rule.RuleResult{
+								{
+									RuleID:   "1",
+									RuleName: "1",
+									CheckResults: []rule.CheckResult{
+										{Status: rule.Failed, Message: "foo", Target: rule.NewTarget("name", "b")},
+										{Status: rule.Passed, Message: "foo", Target: rule.NewTarget("name", "c")},
+										{Status: rule.Failed, Message: "foo", Target: rule.NewTarget("name", "a")},
+										{Status: rule.Failed, Message: "bar"},
+										{Status: rule.Accepted, Message: "baz"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should sort checks and targets deterministically", func() {
+			rep := report.FromProviderResults(providerResults)
+
+			checks := rep.Providers[0].Rulesets[0].Rules[0].Checks
+			Expect(checks).To(HaveLen(4))
+			Expect(checks[0].Status).To(Equal(rule.Passed))
+			Expect(checks[1].Status).To(Equal(rule.Accepted))
+			Expect(checks[2].Message).To(Equal("bar"))
+			Expect(checks[3].Message).To(Equal("foo"))
+			Expect(checks[3].Targets).To(Equal([]rule.Target{rule.NewTarget("name", "a"), rule.NewTarget("name", "b")}))
+		})
+
+		It("should set a fingerprint for every target", func() {
+			rep := report.FromProviderResults(providerResults)
+
+			checks := rep.Providers[0].Rulesets[0].Rules[0].Checks
+			Expect(checks[2].Fingerprints).To(Equal([]string{report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "bar", nil)}))
+			Expect(checks[3].Fingerprints).To(Equal([]string{
+				report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "", rule.NewTarget("name", "a")),
+				report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "", rule.NewTarget("name", "b")),
+			}))
+			Expect(checks[0].Fingerprints).To(Equal([]string{report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "", rule.NewTarget("name", "c"))}))
+		})
+
+		It("should calculate the summary before filtering by the minimal status", func() {
+			rep := report.FromProviderResults(providerResults, report.MinStatus(rule.Failed))
+
+			Expect(rep.MinStatus).To(Equal(rule.Failed))
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks).To(HaveLen(2))
+			Expect(rep.Summary.Targets).To(Equal(map[rule.Status]int{rule.Passed: 1, rule.Accepted: 1, rule.Failed: 3}))
+			Expect(rep.Summary.Rules).To(Equal(map[rule.Status]int{rule.Passed: 1, rule.Accepted: 1, rule.Failed: 1}))
+			Expect(*rep.Summary.ComplianceScore).To(BeZero())
+		})
+
+		It("should include the documentation of rules and keep it when filtering by the minimal status", func() {
+			providerResults[0].RulesetResults[0].RuleResults[0].Documentation = &rule.Documentation{
+				Description: "foo",
+				Remediation: "bar",
+				References:  []rule.Reference{{Type: rule.ReferenceSTIGVulnID, ID: "V-1"}},
+			}
+			rep := report.FromProviderResults(providerResults, report.MinStatus(rule.Failed))
+
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Documentation).To(Equal(&report.Documentation{
+				Description: "foo",
+				Remediation: "bar",
+				References:  []report.Reference{{Type: rule.ReferenceSTIGVulnID, ID: "V-1"}},
+			}))
+		})
+
+		It("should include the exceptions of rulesets", func() {
+			expiresAt := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+			providerResults[0].RulesetResults[0].Exceptions = []ruleset.ExceptionResult{
+				{RuleID: "1", Targets: []rule.Target{rule.NewTarget("name", "a")}, Justification: "foo", Owner: "bar", Ticket: "baz", ExpiresAt: expiresAt, State: ruleset.ExceptionActive, Matches: 1},
+			}
+			rep := report.FromProviderResults(providerResults)
+
+			Expect(rep.Providers[0].Rulesets[0].Exceptions).To(Equal([]report.Exception{
+				{RuleID: "1", Targets: []rule.Target{rule.NewTarget("name", "a")}, Justification: "foo", Owner: "bar", Ticket: "baz", ExpiresAt: expiresAt, State: ruleset.ExceptionActive, Matches: 1},
+			}))
+		})
+	})
+
+	Describe("#WithTargets", func() {
+		It("should only keep accepted targets and their fingerprints", func() {
+			p := report.Provider{
+				ID: "provider-foo",
+				Rulesets: []report.Ruleset{{
+					ID: "ruleset-foo",
+					Rules: []report.Rule{{
+						ID: "1",
+						Checks: []report.Check{
+							{Status: rule.Passed, Message: "foo", Targets: []rule.Target{rule.NewTarget("cluster", "shoot", "name", "a"), rule.NewTarget("cluster", "seed", "name", "b")}, Fingerprints: []string{"fa", "fb"}},
+							{Status: rule.Failed, Message: "bar", Targets: []rule.Target{rule.NewTarget("cluster", "seed", "name", "c")}, Fingerprints: []string{"fc"}},
+							{Status: rule.Errored, Message: "baz", Fingerprints: []string{"fd"}},
+						},
+					}},
+				}},
+			}
+
+			filtered := p.WithTargets(func(target rule.Target) bool { return target["cluster"] == "shoot" })
+
+			Expect(filtered.Rulesets[0].Rules[0].Checks).To(Equal([]report.Check{
+				{Status: rule.Passed, Message: "foo", Targets: []rule.Target{rule.NewTarget("cluster", "shoot", "name", "a")}, Fingerprints: []string{"fa"}},
+			}))
+			Expect(p.Rulesets[0].Rules[0].Checks).To(HaveLen(3))
+			Expect(p.Rulesets[0].Rules[0].Checks[0].Targets).To(HaveLen(2))
+		})
+	})
+
+	Describe("#Fingerprint", func() {
+		fingerprint := report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "", rule.NewTarget("name", "foo", "namespace", "bar"))
+
+		It("should be stable", func() {
+			Expect(fingerprint).To(HaveLen(32))
+			Expect(report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "", rule.Target{"namespace": "bar", "name": "foo"})).To(Equal(fingerprint))
+		})
+
+		It("should not depend on the check status", func() {
+			providerResults := []provider.ProviderResult{{
+				ProviderID: "provider-foo",
+				RulesetResults: []ruleset.RulesetResult{{
+					RulesetID:      "ruleset-foo",
+					RulesetVersion: "v1",
+					RuleResults: []rule.RuleResult{{
+						RuleID: "1",
+						CheckResults: []rule.CheckResult{
+							rule.FailedCheckResult("Ingress traffic is not denied by default.", rule.NewTarget("name", "foo", "namespace", "bar")),
+						},
+					}},
+				}},
+			}}
+			failedReport := report.FromProviderResults(providerResults)
+			providerResults[0].RulesetResults[0].RuleResults[0].CheckResults = []rule.CheckResult{
+				rule.PassedCheckResult("Ingress traffic is denied by default.", rule.NewTarget("name", "foo", "namespace", "bar")),
+			}
+			passedReport := report.FromProviderResults(providerResults)
+
+			Expect(failedReport.Providers[0].Rulesets[0].Rules[0].Checks[0].Fingerprints).To(Equal([]string{fingerprint}))
+			Expect(passedReport.Providers[0].Rulesets[0].Rules[0].Checks[0].Fingerprints).To(Equal([]string{fingerprint}))
+		})
+
+		It("should differ for checks of a rule with the same target and different messages", func() {
+			providerResults := []provider.ProviderResult{{
+				ProviderID: "provider-foo",
+				RulesetResults: []ruleset.RulesetResult{{
+					RulesetID:      "ruleset-foo",
+					RulesetVersion: "v1",
+					RuleResults: []rule.RuleResult{{
+						RuleID: "1",
+						CheckResults: []rule.CheckResult{
+							rule.FailedCheckResult("Option foo not set.", rule.NewTarget("name", "foo", "namespace", "bar")),
+							rule.FailedCheckResult("Option bar not set.", rule.NewTarget("name", "foo", "namespace", "bar")),
+						},
+					}},
+				}},
+			}}
+			checks := report.FromProviderResults(providerResults).Providers[0].Rulesets[0].Rules[0].Checks
+
+			Expect(checks).To(HaveLen(2))
+			Expect(checks[0].Fingerprints).To(Equal([]string{report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "option bar not set.", rule.NewTarget("name", "foo", "namespace", "bar"))}))
+			Expect(checks[1].Fingerprints).To(Equal([]string{report.Fingerprint("provider-foo", "ruleset-foo", "v1", "1", "option foo not set.", rule.NewTarget("name", "foo", "namespace", "bar"))}))
+			Expect(checks[0].Fingerprints).ToNot(Equal(checks[1].Fingerprints))
+		})
+
+		It("should normalize the message key", func() {
+			Expect(report.MessageKey("  Option  foo\tnot set. ")).To(Equal("option foo not set."))
+		})
+
+		DescribeTable("should differ when an attribute differs",
+			func(providerID, rulesetID, rulesetVersion, ruleID, messageKey string, target rule.Target) {
+				Expect(report.Fingerprint(providerID, rulesetID, rulesetVersion, ruleID, messageKey, target)).ToNot(Equal(fingerprint))
+			},
+			Entry("provider", "provider-bar", "ruleset-foo", "v1", "1", "", rule.NewTarget("name", "foo", "namespace", "bar")),
+			Entry("ruleset", "provider-foo", "ruleset-bar", "v1", "1", "", rule.NewTarget("name", "foo", "namespace", "bar")),
+			Entry("ruleset version", "provider-foo", "ruleset-foo", "v2", "1", "", rule.NewTarget("name", "foo", "namespace", "bar")),
+			Entry("rule", "provider-foo", "ruleset-foo", "v1", "2", "", rule.NewTarget("name", "foo", "namespace", "bar")),
+			Entry("message key", "provider-foo", "ruleset-foo", "v1", "1", "foo", rule.NewTarget("name", "foo", "namespace", "bar")),
+			Entry("target", "provider-foo", "ruleset-foo", "v1", "1", "", rule.NewTarget("name", "foo", "namespace", "baz")),
+			Entry("concatenation", "provider-foo", "ruleset-foo", "v1", "1", "", rule.NewTarget("name", "foo", "names", "pace=bar")),
+		)
 	})
 
 })
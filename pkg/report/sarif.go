@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	sarifVersion        = "2.1.0"
+	sarifSchema         = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifInformationURI = "https://github.com/gardener/diki"
+)
+
+// SARIFLog is the root object of a SARIF 2.1.0 log file.
+type SARIFLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun describes a single run of an analysis tool.
+// Diki produces a run for every ruleset of a provider.
+type SARIFRun struct {
+	Tool       SARIFTool      `json:"tool"`
+	Results    []SARIFResult  `json:"results"`
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+// SARIFTool describes the analysis tool of a run.
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// SARIFDriver describes the component that ran the analysis, i.e. a ruleset.
+type SARIFDriver struct {
+	Name           string         `json:"name"`
+	Version        string         `json:"version,omitempty"`
+	InformationURI string         `json:"informationUri,omitempty"`
+	Rules          []SARIFRule    `json:"rules"`
+	Properties     map[string]any `json:"properties,omitempty"`
+}
+
+// SARIFRule describes a rule of a ruleset.
+type SARIFRule struct {
+	ID                   string                  `json:"id"`
+	Name                 string                  `json:"name,omitempty"`
+	ShortDescription     *SARIFMessage           `json:"shortDescription,omitempty"`
+	DefaultConfiguration *SARIFRuleConfiguration `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]any          `json:"properties,omitempty"`
+}
+
+// SARIFRuleConfiguration describes the default configuration of a rule.
+type SARIFRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+// SARIFMessage is a plain text message.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFResult describes a single check target reported by a rule.
+type SARIFResult struct {
+	RuleID        string             `json:"ruleId"`
+	RuleIndex     int                `json:"ruleIndex"`
+	Kind          string             `json:"kind"`
+	Level         string             `json:"level"`
+	Message       SARIFMessage       `json:"message"`
+	BaselineState string             `json:"baselineState,omitempty"`
+	Suppressions  []SARIFSuppression `json:"suppressions,omitempty"`
+	Properties    map[string]string  `json:"properties,omitempty"`
+}
+
+// SARIFSuppression describes a request to suppress a result.
+type SARIFSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// SARIFRenderer renders Diki reports in SARIF 2.1.0 format.
+type SARIFRenderer struct{}
+
+// NewSARIFRenderer creates a SARIFRenderer.
+func NewSARIFRenderer() *SARIFRenderer {
+	return &SARIFRenderer{}
+}
+
+// Render writes a Diki report in SARIF format into the passed writer.
+func (r *SARIFRenderer) Render(w io.Writer, report any) error {
+	var sarifLog *SARIFLog
+	switch rep := report.(type) {
+	case *Report:
+		sarifLog = SARIFFromReport(rep)
+	case *MergedReport:
+		sarifLog = SARIFFromMergedReport(rep)
+	default:
+		return fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog)
+}
+
+// SARIFFromReport converts a Diki report to a SARIF log.
+func SARIFFromReport(report *Report) *SARIFLog {
+	sarifLog := newSARIFLog()
+	for _, provider := range report.Providers {
+		for _, ruleset := range provider.Rulesets {
+			run := newSARIFRun(ruleset.ID, ruleset.Name, ruleset.Version)
+			run.Properties = map[string]any{
+				"providerID":   provider.ID,
+				"providerName": provider.Name,
+				"time":         report.Time,
+				"dikiVersion":  report.DikiVersion,
+			}
+			if len(provider.Metadata) > 0 {
+				run.Properties["metadata"] = provider.Metadata
+			}
+
+			for _, r := range ruleset.Rules {
+				ruleIndex := run.addRule(r.ID, r.Name, r.Severity)
+				for _, check := range r.Checks {
+					var baselineState string
+					if report.Baseline != nil {
+						baselineState = sarifBaselineState(check.Baseline)
+					}
+					run.addResults(ruleIndex, r.Severity, check.Status, check.Message, check.Targets, baselineState)
+				}
+			}
+			sarifLog.Runs = append(sarifLog.Runs, run)
+		}
+	}
+	return sarifLog
+}
+
+// SARIFFromMergedReport converts a merged Diki report to a SARIF log.
+// A separate run is created for every ruleset of every merged report.
+func SARIFFromMergedReport(report *MergedReport) *SARIFLog {
+	sarifLog := newSARIFLog()
+	for _, provider := range report.Providers {
+		for _, distinctValue := range sortedKeys(provider.Metadata) {
+			for _, ruleset := range provider.Rulesets {
+				run := newSARIFRun(ruleset.ID, ruleset.Name, ruleset.Version)
+				run.Properties = map[string]any{
+					"providerID":   provider.ID,
+					"providerName": provider.Name,
+					"distinctBy":   provider.DistinctBy,
+					"dikiVersion":  report.DikiVersion,
+				}
+				if len(provider.Metadata[distinctValue]) > 0 {
+					run.Properties["metadata"] = provider.Metadata[distinctValue]
+				}
+
+				for _, r := range ruleset.Rules {
+					ruleIndex := run.addRule(r.ID, r.Name, r.Severity)
+					for _, check := range r.Checks {
+						targets, ok := check.ReportsTargets[distinctValue]
+						if !ok {
+							continue
+						}
+						run.addResults(ruleIndex, r.Severity, check.Status, check.Message, targets, "")
+					}
+				}
+				sarifLog.Runs = append(sarifLog.Runs, run)
+			}
+		}
+	}
+	return sarifLog
+}
+
+func newSARIFLog() *SARIFLog {
+	return &SARIFLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []SARIFRun{},
+	}
+}
+
+func newSARIFRun(rulesetID, rulesetName, rulesetVersion string) SARIFRun {
+	return SARIFRun{
+		Tool: SARIFTool{
+			Driver: SARIFDriver{
+				Name:           rulesetName,
+				Version:        rulesetVersion,
+				InformationURI: sarifInformationURI,
+				Rules:          []SARIFRule{},
+				Properties: map[string]any{
+					"rulesetID": rulesetID,
+				},
+			},
+		},
+		Results: []SARIFResult{},
+	}
+}
+
+// addRule adds a rule to the driver of the run and returns its index.
+func (run *SARIFRun) addRule(id, name string, severity rule.SeverityLevel) int {
+	sarifRule := SARIFRule{
+		ID:               id,
+		Name:             name,
+		ShortDescription: &SARIFMessage{Text: name},
+		DefaultConfiguration: &SARIFRuleConfiguration{
+			Level: sarifLevel(severity),
+		},
+	}
+	if len(severity) > 0 {
+		sarifRule.Properties = map[string]any{
+			"severity": severity,
+		}
+	}
+	run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule)
+	return len(run.Tool.Driver.Rules) - 1
+}
+
+// addResults adds a result for every target of a check to the run.
+// A single result without properties is added for checks without targets.
+func (run *SARIFRun) addResults(ruleIndex int, severity rule.SeverityLevel, status rule.Status, message string, targets []rule.Target, baselineState string) {
+	if len(targets) == 0 {
+		targets = []rule.Target{nil}
+	}
+
+	ruleID := run.Tool.Driver.Rules[ruleIndex].ID
+	for _, target := range targets {
+		result := SARIFResult{
+			RuleID:        ruleID,
+			RuleIndex:     ruleIndex,
+			Kind:          sarifKind(status),
+			Level:         "none",
+			Message:       SARIFMessage{Text: message},
+			BaselineState: baselineState,
+		}
+		if result.Kind == "fail" {
+			result.Level = sarifLevel(severity)
+		}
+		if status == rule.Accepted {
+			result.Suppressions = []SARIFSuppression{{Kind: "external", Justification: message}}
+		}
+		if len(target) > 0 {
+			result.Properties = maps.Clone(map[string]string(target))
+		}
+		run.Results = append(run.Results, result)
+	}
+}
+
+// sarifBaselineState maps the baseline flag of a check to a SARIF baseline state.
+func sarifBaselineState(baseline bool) string {
+	if baseline {
+		return "unchanged"
+	}
+	return "new"
+}
+
+// sarifLevel maps a rule severity to a SARIF level.
+// Rules without severity are reported with the SARIF default level warning.
+func sarifLevel(severity rule.SeverityLevel) string {
+	switch severity {
+	case rule.SeverityHigh:
+		return "error"
+	case rule.SeverityLow:
+		return "note"
+	default:
+		return "warning"
+	}
+}
+
+// sarifKind maps a check status to a SARIF result kind.
+// Accepted checks are reported as failed, but suppressed, results.
+func sarifKind(status rule.Status) string {
+	switch status {
+	case rule.Passed:
+		return "pass"
+	case rule.Failed, rule.Accepted:
+		return "fail"
+	case rule.Skipped:
+		return "notApplicable"
+	case rule.Warning:
+		return "review"
+	default:
+		return "open"
+	}
+}
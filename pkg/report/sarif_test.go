@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("sarif", func() {
+	var (
+		reportTime time.Time
+		rep        *report.Report
+	)
+
+	BeforeEach(func() {
+		reportTime = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		rep = &report.Report{
+			Time:        reportTime,
+			DikiVersion: "1",
+			Providers: []report.Provider{
+				{
+					ID:       "provider-foo",
+					Name:     "Provider Foo",
+					Metadata: map[string]string{"id": "foo"},
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:       "1",
+									Name:     "Rule 1",
+									Severity: rule.SeverityHigh,
+									Checks: []report.Check{
+										{
+											Status:  rule.Failed,
+											Message: "failed",
+											Targets: []rule.Target{rule.NewTarget("name", "foo"), rule.NewTarget("name", "bar")},
+										},
+										{
+											Status:  rule.Accepted,
+											Message: "accepted",
+											Targets: []rule.Target{rule.NewTarget("name", "baz")},
+										},
+									},
+								},
+								{
+									ID:   "2",
+									Name: "Rule 2",
+									Checks: []report.Check{
+										{
+											Status:  rule.Passed,
+											Message: "passed",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#SARIFFromReport", func() {
+		It("should map rulesets to drivers and check targets to results", func() {
+			sarifLog := report.SARIFFromReport(rep)
+
+			Expect(sarifLog.Version).To(Equal("2.1.0"))
+			Expect(sarifLog.Runs).To(HaveLen(1))
+
+			run := sarifLog.Runs[0]
+			Expect(run.Tool.Driver.Name).To(Equal("Ruleset Foo"))
+			Expect(run.Tool.Driver.Version).To(Equal("v1"))
+			Expect(run.Tool.Driver.Properties).To(HaveKeyWithValue("rulesetID", "ruleset-foo"))
+			Expect(run.Properties).To(HaveKeyWithValue("providerID", "provider-foo"))
+			Expect(run.Properties).To(HaveKeyWithValue("metadata", map[string]string{"id": "foo"}))
+
+			Expect(run.Tool.Driver.Rules).To(Equal([]report.SARIFRule{
+				{
+					ID:                   "1",
+					Name:                 "Rule 1",
+					ShortDescription:     &report.SARIFMessage{Text: "Rule 1"},
+					DefaultConfiguration: &report.SARIFRuleConfiguration{Level: "error"},
+					Properties:           map[string]any{"severity": rule.SeverityHigh},
+				},
+				{
+					ID:                   "2",
+					Name:                 "Rule 2",
+					ShortDescription:     &report.SARIFMessage{Text: "Rule 2"},
+					DefaultConfiguration: &report.SARIFRuleConfiguration{Level: "warning"},
+				},
+			}))
+
+			Expect(run.Results).To(Equal([]report.SARIFResult{
+				{RuleID: "1", RuleIndex: 0, Kind: "fail", Level: "error", Message: report.SARIFMessage{Text: "failed"}, Properties: map[string]string{"name": "foo"}},
+				{RuleID: "1", RuleIndex: 0, Kind: "fail", Level: "error", Message: report.SARIFMessage{Text: "failed"}, Properties: map[string]string{"name": "bar"}},
+				{
+					RuleID:       "1",
+					RuleIndex:    0,
+					Kind:         "fail",
+					Level:        "error",
+					Message:      report.SARIFMessage{Text: "accepted"},
+					Suppressions: []report.SARIFSuppression{{Kind: "external", Justification: "accepted"}},
+					Properties:   map[string]string{"name": "baz"},
+				},
+				{RuleID: "2", RuleIndex: 1, Kind: "pass", Level: "none", Message: report.SARIFMessage{Text: "passed"}},
+			}))
+		})
+
+		It("should set the baseline state of results when a baseline is applied", func() {
+			baseline := &report.Report{
+				Providers: []report.Provider{
+					{
+						ID: "provider-foo",
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{ID: "1", Checks: []report.Check{{Status: rule.Failed, Targets: []rule.Target{rule.NewTarget("name", "foo")}}}},
+								},
+							},
+						},
+					},
+				},
+			}
+			rep.ApplyBaseline(baseline)
+
+			baselineStates := map[string]string{}
+			for _, result := range report.SARIFFromReport(rep).Runs[0].Results {
+				baselineStates[result.Message.Text+"/"+result.Properties["name"]] = result.BaselineState
+			}
+			Expect(baselineStates).To(Equal(map[string]string{
+				"failed/foo":   "unchanged",
+				"failed/bar":   "new",
+				"accepted/baz": "new",
+				"passed/":      "new",
+			}))
+		})
+	})
+
+	Describe("#SARIFFromMergedReport", func() {
+		It("should create a run for every merged report", func() {
+			otherRep := &report.Report{
+				Time:        reportTime,
+				DikiVersion: "1",
+				Providers: []report.Provider{
+					{
+						ID:       "provider-foo",
+						Name:     "Provider Foo",
+						Metadata: map[string]string{"id": "bar"},
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Name:    "Ruleset Foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{
+										ID:       "1",
+										Name:     "Rule 1",
+										Severity: rule.SeverityHigh,
+										Checks: []report.Check{
+											{
+												Status:  rule.Errored,
+												Message: "errored",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			mergedReport, err := report.MergeReport([]*report.Report{rep, otherRep}, map[string]string{"provider-foo": "id"})
+			Expect(err).ToNot(HaveOccurred())
+
+			sarifLog := report.SARIFFromMergedReport(mergedReport)
+			Expect(sarifLog.Runs).To(HaveLen(2))
+
+			Expect(sarifLog.Runs[0].Properties).To(HaveKeyWithValue("distinctBy", "id"))
+			Expect(sarifLog.Runs[0].Results).To(Equal([]report.SARIFResult{
+				{RuleID: "1", RuleIndex: 0, Kind: "open", Level: "none", Message: report.SARIFMessage{Text: "errored"}},
+			}))
+			Expect(sarifLog.Runs[1].Results).To(HaveLen(4))
+		})
+	})
+
+	Describe("#Render", func() {
+		It("should render valid sarif json", func() {
+			buf := &bytes.Buffer{}
+			Expect(report.NewSARIFRenderer().Render(buf, rep)).To(Succeed())
+
+			var sarifLog map[string]any
+			Expect(json.Unmarshal(buf.Bytes(), &sarifLog)).To(Succeed())
+			Expect(sarifLog).To(HaveKeyWithValue("version", "2.1.0"))
+			Expect(sarifLog).To(HaveKeyWithValue("$schema", "https://json.schemastore.org/sarif-2.1.0.json"))
+		})
+
+		It("should return error for unsupported report types", func() {
+			Expect(report.NewSARIFRenderer().Render(&bytes.Buffer{}, &report.DifferenceReportsWrapper{})).To(MatchError("unsupported report type: *report.DifferenceReportsWrapper"))
+		})
+	})
+})
@@ -0,0 +1,460 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	disaKubernetesSTIGRulesetID = "disa-kubernetes-stig"
+	disaKubernetesSTIGID        = "Kubernetes_STIG"
+	disaKubernetesSTIGTitle     = "Kubernetes Security Technical Implementation Guide"
+)
+
+var disaSTIGVersionRegex = regexp.MustCompile(`^v(\d+)r(\d+)$`)
+
+// stigStatus is the status of a vulnerability in a STIG Viewer checklist.
+type stigStatus string
+
+const (
+	stigOpen          stigStatus = "Open"
+	stigNotAFinding   stigStatus = "NotAFinding"
+	stigNotApplicable stigStatus = "Not_Applicable"
+	stigNotReviewed   stigStatus = "Not_Reviewed"
+)
+
+// stigVuln contains the checklist relevant information of a single rule.
+type stigVuln struct {
+	id             string
+	title          string
+	severity       string
+	status         stigStatus
+	findingDetails string
+	comments       string
+}
+
+// stigBenchmark contains the checklist relevant information of a single ruleset.
+type stigBenchmark struct {
+	version string
+	release string
+	vulns   []stigVuln
+}
+
+// stigChecklist contains the checklist relevant information of a provider.
+type stigChecklist struct {
+	providerID    string
+	targetComment string
+	benchmarks    []stigBenchmark
+}
+
+// STIGProviders returns the IDs of the providers in the report
+// that contain results of a DISA Kubernetes STIG ruleset.
+func STIGProviders(report *Report) []string {
+	var providerIDs []string
+	for _, provider := range report.Providers {
+		if slices.ContainsFunc(provider.Rulesets, isDISAKubernetesSTIG) {
+			providerIDs = append(providerIDs, provider.ID)
+		}
+	}
+	return providerIDs
+}
+
+func isDISAKubernetesSTIG(ruleset Ruleset) bool {
+	return ruleset.ID == disaKubernetesSTIGRulesetID
+}
+
+// CKLRenderer renders the DISA STIG results of a single provider
+// as a STIG Viewer 2 checklist (CKL).
+type CKLRenderer struct {
+	providerID string
+}
+
+// NewCKLRenderer creates a CKLRenderer for the provider with the given ID.
+// If the ID is empty the report has to contain results for exactly one provider.
+func NewCKLRenderer(providerID string) *CKLRenderer {
+	return &CKLRenderer{providerID: providerID}
+}
+
+// Render writes the checklist of a Diki report in CKL format into the passed writer.
+func (r *CKLRenderer) Render(w io.Writer, report any) error {
+	checklist, err := newSTIGChecklist(report, r.providerID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "\t")
+	if err := encoder.Encode(checklist.ckl()); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// CKLBRenderer renders the DISA STIG results of a single provider
+// as a STIG Viewer 3 checklist (CKLB).
+type CKLBRenderer struct {
+	providerID string
+}
+
+// NewCKLBRenderer creates a CKLBRenderer for the provider with the given ID.
+// If the ID is empty the report has to contain results for exactly one provider.
+func NewCKLBRenderer(providerID string) *CKLBRenderer {
+	return &CKLBRenderer{providerID: providerID}
+}
+
+// Render writes the checklist of a Diki report in CKLB format into the passed writer.
+func (r *CKLBRenderer) Render(w io.Writer, report any) error {
+	checklist, err := newSTIGChecklist(report, r.providerID)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(checklist.cklb())
+}
+
+func newSTIGChecklist(report any, providerID string) (*stigChecklist, error) {
+	rep, ok := report.(*Report)
+	if !ok {
+		return nil, fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	var provider *Provider
+	switch {
+	case len(providerID) > 0:
+		idx := slices.IndexFunc(rep.Providers, func(p Provider) bool {
+			return p.ID == providerID
+		})
+		if idx < 0 {
+			return nil, fmt.Errorf("provider %s not found in report", providerID)
+		}
+		provider = &rep.Providers[idx]
+	case len(rep.Providers) == 1:
+		provider = &rep.Providers[0]
+	default:
+		return nil, fmt.Errorf("checklists are created for a single provider, report contains %d providers", len(rep.Providers))
+	}
+
+	checklist := &stigChecklist{
+		providerID:    provider.ID,
+		targetComment: stigTargetComment(provider),
+	}
+	for _, ruleset := range provider.Rulesets {
+		if !isDISAKubernetesSTIG(ruleset) {
+			continue
+		}
+
+		benchmark := stigBenchmark{version: ruleset.Version}
+		if matches := disaSTIGVersionRegex.FindStringSubmatch(ruleset.Version); matches != nil {
+			benchmark.version, benchmark.release = matches[1], matches[2]
+		}
+		for _, r := range ruleset.Rules {
+			benchmark.vulns = append(benchmark.vulns, newSTIGVuln(r))
+		}
+		slices.SortFunc(benchmark.vulns, func(a, b stigVuln) int {
+			return strings.Compare(a.id, b.id)
+		})
+		checklist.benchmarks = append(checklist.benchmarks, benchmark)
+	}
+
+	if len(checklist.benchmarks) == 0 {
+		return nil, fmt.Errorf("provider %s does not contain results of ruleset %s", provider.ID, disaKubernetesSTIGRulesetID)
+	}
+	return checklist, nil
+}
+
+func stigTargetComment(provider *Provider) string {
+	lines := []string{fmt.Sprintf("Provider: %s (%s)", provider.Name, provider.ID)}
+	for _, key := range sortedKeys(provider.Metadata) {
+		lines = append(lines, fmt.Sprintf("%s: %s", key, provider.Metadata[key]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func newSTIGVuln(r Rule) stigVuln {
+	vuln := stigVuln{
+		id:       r.ID,
+		title:    r.Name,
+		severity: strings.ToLower(string(r.Severity)),
+		status:   stigVulnStatus(r.Checks),
+	}
+
+	var details, comments []string
+	for _, check := range r.Checks {
+		text := checkText(check)
+		switch check.Status {
+		case rule.Accepted, rule.Skipped:
+			comments = append(comments, text)
+		default:
+			details = append(details, text)
+		}
+	}
+	vuln.findingDetails = strings.Join(details, "\n\n")
+	vuln.comments = strings.Join(comments, "\n\n")
+	return vuln
+}
+
+// stigVulnStatus aggregates the statuses of the checks of a rule to a checklist status.
+// Failed checks take precedence over checks that could not be evaluated.
+// Accepted checks are not considered findings.
+func stigVulnStatus(checks []Check) stigStatus {
+	statuses := make([]rule.Status, 0, len(checks))
+	for _, check := range checks {
+		statuses = append(statuses, check.Status)
+	}
+
+	switch {
+	case len(statuses) == 0:
+		return stigNotReviewed
+	case slices.Contains(statuses, rule.Failed):
+		return stigOpen
+	case slices.ContainsFunc(statuses, func(s rule.Status) bool {
+		return s != rule.Passed && s != rule.Accepted && s != rule.Skipped
+	}):
+		return stigNotReviewed
+	case slices.Contains(statuses, rule.Passed) || slices.Contains(statuses, rule.Accepted):
+		return stigNotAFinding
+	default:
+		return stigNotApplicable
+	}
+}
+
+// checkText returns the status, message and targets of a check as plain text.
+func checkText(check Check) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s: %s", check.Status, check.Message))
+	for _, target := range check.Targets {
+		attributes := make([]string, 0, len(target))
+		for _, key := range slices.Sorted(maps.Keys(target)) {
+			attributes = append(attributes, fmt.Sprintf("%s: %s", key, target[key]))
+		}
+		sb.WriteString(fmt.Sprintf("\n- %s", strings.Join(attributes, ", ")))
+	}
+	return sb.String()
+}
+
+func (b stigBenchmark) releaseInfo() string {
+	if len(b.release) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Release: %s", b.release)
+}
+
+func (b stigBenchmark) stigRef() string {
+	if len(b.release) == 0 {
+		return fmt.Sprintf("%s :: %s", disaKubernetesSTIGTitle, b.version)
+	}
+	return fmt.Sprintf("%s :: Version %s, Release: %s", disaKubernetesSTIGTitle, b.version, b.release)
+}
+
+type cklChecklist struct {
+	XMLName xml.Name  `xml:"CHECKLIST"`
+	Asset   cklAsset  `xml:"ASSET"`
+	STIGs   []cklSTIG `xml:"STIGS>iSTIG"`
+}
+
+type cklAsset struct {
+	Role          string `xml:"ROLE"`
+	AssetType     string `xml:"ASSET_TYPE"`
+	HostName      string `xml:"HOST_NAME"`
+	HostIP        string `xml:"HOST_IP"`
+	HostMAC       string `xml:"HOST_MAC"`
+	HostFQDN      string `xml:"HOST_FQDN"`
+	TargetComment string `xml:"TARGET_COMMENT"`
+	TechArea      string `xml:"TECH_AREA"`
+	TargetKey     string `xml:"TARGET_KEY"`
+	WebOrDatabase bool   `xml:"WEB_OR_DATABASE"`
+	WebDBSite     string `xml:"WEB_DB_SITE"`
+	WebDBInstance string `xml:"WEB_DB_INSTANCE"`
+}
+
+type cklSTIG struct {
+	Info  []cklSIData `xml:"STIG_INFO>SI_DATA"`
+	Vulns []cklVuln   `xml:"VULN"`
+}
+
+type cklSIData struct {
+	Name string `xml:"SID_NAME"`
+	Data string `xml:"SID_DATA"`
+}
+
+type cklVuln struct {
+	Data                  []cklSTIGData `xml:"STIG_DATA"`
+	Status                stigStatus    `xml:"STATUS"`
+	FindingDetails        string        `xml:"FINDING_DETAILS"`
+	Comments              string        `xml:"COMMENTS"`
+	SeverityOverride      string        `xml:"SEVERITY_OVERRIDE"`
+	SeverityJustification string        `xml:"SEVERITY_JUSTIFICATION"`
+}
+
+type cklSTIGData struct {
+	Attribute string `xml:"VULN_ATTRIBUTE"`
+	Data      string `xml:"ATTRIBUTE_DATA"`
+}
+
+func (c *stigChecklist) ckl() cklChecklist {
+	checklist := cklChecklist{
+		Asset: cklAsset{
+			Role:          "None",
+			AssetType:     "Computing",
+			TargetComment: c.targetComment,
+		},
+	}
+	for _, benchmark := range c.benchmarks {
+		stig := cklSTIG{
+			Info: []cklSIData{
+				{Name: "version", Data: benchmark.version},
+				{Name: "stigid", Data: disaKubernetesSTIGID},
+				{Name: "releaseinfo", Data: benchmark.releaseInfo()},
+				{Name: "title", Data: disaKubernetesSTIGTitle},
+			},
+		}
+		for _, vuln := range benchmark.vulns {
+			stig.Vulns = append(stig.Vulns, cklVuln{
+				Data: []cklSTIGData{
+					{Attribute: "Vuln_Num", Data: "V-" + vuln.id},
+					{Attribute: "Severity", Data: vuln.severity},
+					{Attribute: "Rule_Title", Data: vuln.title},
+					{Attribute: "STIGRef", Data: benchmark.stigRef()},
+				},
+				Status:         vuln.status,
+				FindingDetails: vuln.findingDetails,
+				Comments:       vuln.comments,
+			})
+		}
+		checklist.STIGs = append(checklist.STIGs, stig)
+	}
+	return checklist
+}
+
+type cklbChecklist struct {
+	Title       string         `json:"title"`
+	ID          string         `json:"id"`
+	TargetData  cklbTargetData `json:"target_data"`
+	STIGs       []cklbSTIG     `json:"stigs"`
+	Active      bool           `json:"active"`
+	Mode        int            `json:"mode"`
+	HasPath     bool           `json:"has_path"`
+	CKLBVersion string         `json:"cklb_version"`
+}
+
+type cklbTargetData struct {
+	TargetType     string `json:"target_type"`
+	HostName       string `json:"host_name"`
+	IPAddress      string `json:"ip_address"`
+	MACAddress     string `json:"mac_address"`
+	FQDN           string `json:"fqdn"`
+	Comments       string `json:"comments"`
+	Role           string `json:"role"`
+	IsWebDatabase  bool   `json:"is_web_database"`
+	TechnologyArea string `json:"technology_area"`
+	WebDBSite      string `json:"web_db_site"`
+	WebDBInstance  string `json:"web_db_instance"`
+}
+
+type cklbSTIG struct {
+	STIGName    string     `json:"stig_name"`
+	DisplayName string     `json:"display_name"`
+	STIGID      string     `json:"stig_id"`
+	ReleaseInfo string     `json:"release_info"`
+	Version     string     `json:"version"`
+	UUID        string     `json:"uuid"`
+	Size        int        `json:"size"`
+	Rules       []cklbRule `json:"rules"`
+}
+
+type cklbRule struct {
+	UUID           string     `json:"uuid"`
+	STIGUUID       string     `json:"stig_uuid"`
+	GroupID        string     `json:"group_id"`
+	RuleTitle      string     `json:"rule_title"`
+	Severity       string     `json:"severity"`
+	Status         string     `json:"status"`
+	FindingDetails string     `json:"finding_details"`
+	Comments       string     `json:"comments"`
+	Overrides      struct{}   `json:"overrides"`
+	STIGRef        string     `json:"stig_ref,omitempty"`
+	Reviews        []struct{} `json:"reviews"`
+}
+
+func (c *stigChecklist) cklb() cklbChecklist {
+	checklistUUID := stigUUID(c.providerID, c.targetComment)
+	checklist := cklbChecklist{
+		Title: fmt.Sprintf("%s - %s", disaKubernetesSTIGTitle, c.providerID),
+		ID:    checklistUUID,
+		TargetData: cklbTargetData{
+			TargetType: "Computing",
+			Comments:   c.targetComment,
+			Role:       "None",
+		},
+		STIGs:       []cklbSTIG{},
+		HasPath:     true,
+		Mode:        1,
+		CKLBVersion: "1.0",
+	}
+	for _, benchmark := range c.benchmarks {
+		stig := cklbSTIG{
+			STIGName:    disaKubernetesSTIGTitle,
+			DisplayName: "Kubernetes",
+			STIGID:      disaKubernetesSTIGID,
+			ReleaseInfo: benchmark.releaseInfo(),
+			Version:     benchmark.version,
+			UUID:        stigUUID(checklistUUID, benchmark.version, benchmark.release),
+			Size:        len(benchmark.vulns),
+			Rules:       []cklbRule{},
+		}
+		for _, vuln := range benchmark.vulns {
+			stig.Rules = append(stig.Rules, cklbRule{
+				UUID:           stigUUID(stig.UUID, vuln.id),
+				STIGUUID:       stig.UUID,
+				GroupID:        "V-" + vuln.id,
+				RuleTitle:      vuln.title,
+				Severity:       vuln.severity,
+				Status:         cklbStatus(vuln.status),
+				FindingDetails: vuln.findingDetails,
+				Comments:       vuln.comments,
+				STIGRef:        benchmark.stigRef(),
+				Reviews:        []struct{}{},
+			})
+		}
+		checklist.STIGs = append(checklist.STIGs, stig)
+	}
+	return checklist
+}
+
+// stigUUID returns a deterministic UUID for the given names.
+func stigUUID(names ...string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(strings.Join(append([]string{disaKubernetesSTIGID}, names...), "/"))).String()
+}
+
+func cklbStatus(status stigStatus) string {
+	switch status {
+	case stigOpen:
+		return "open"
+	case stigNotAFinding:
+		return "not_a_finding"
+	case stigNotApplicable:
+		return "not_applicable"
+	default:
+		return "not_reviewed"
+	}
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("stig checklist", func() {
+	type cklVuln struct {
+		Data []struct {
+			Attribute string `xml:"VULN_ATTRIBUTE"`
+			Data      string `xml:"ATTRIBUTE_DATA"`
+		} `xml:"STIG_DATA"`
+		Status         string `xml:"STATUS"`
+		FindingDetails string `xml:"FINDING_DETAILS"`
+		Comments       string `xml:"COMMENTS"`
+	}
+	type cklChecklist struct {
+		TargetComment string `xml:"ASSET>TARGET_COMMENT"`
+		STIGs         []struct {
+			Info []struct {
+				Name string `xml:"SID_NAME"`
+				Data string `xml:"SID_DATA"`
+			} `xml:"STIG_INFO>SI_DATA"`
+			Vulns []cklVuln `xml:"VULN"`
+		} `xml:"STIGS>iSTIG"`
+	}
+	type cklbChecklist struct {
+		ID    string `json:"id"`
+		STIGs []struct {
+			STIGID      string `json:"stig_id"`
+			Version     string `json:"version"`
+			ReleaseInfo string `json:"release_info"`
+			Rules       []struct {
+				GroupID        string `json:"group_id"`
+				Severity       string `json:"severity"`
+				Status         string `json:"status"`
+				FindingDetails string `json:"finding_details"`
+				Comments       string `json:"comments"`
+			} `json:"rules"`
+		} `json:"stigs"`
+	}
+
+	var (
+		rep          *report.Report
+		stigProvider = func(id string) report.Provider {
+			return report.Provider{
+				ID:       id,
+				Name:     "Provider Foo",
+				Metadata: map[string]string{"id": "foo"},
+				Rulesets: []report.Ruleset{
+					{
+						ID:      "disa-kubernetes-stig",
+						Name:    "DISA Kubernetes Security Technical Implementation Guide",
+						Version: "v2r3",
+						Rules: []report.Rule{
+							{
+								ID:       "242390",
+								Name:     "Rule 242390",
+								Severity: rule.SeverityHigh,
+								Checks: []report.Check{
+									{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "foo")}},
+									{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "bar", "kind", "Pod")}},
+								},
+							},
+							{
+								ID:       "242376",
+								Name:     "Rule 242376",
+								Severity: rule.SeverityMedium,
+								Checks: []report.Check{
+									{Status: rule.Passed, Message: "passed"},
+									{Status: rule.Accepted, Message: "accepted justification"},
+								},
+							},
+							{
+								ID:       "242377",
+								Name:     "Rule 242377",
+								Severity: rule.SeverityMedium,
+								Checks: []report.Check{
+									{Status: rule.Skipped, Message: "skipped justification"},
+								},
+							},
+							{
+								ID:       "242378",
+								Name:     "Rule 242378",
+								Severity: rule.SeverityLow,
+								Checks: []report.Check{
+									{Status: rule.Skipped, Message: "skipped"},
+									{Status: rule.Errored, Message: "errored"},
+								},
+							},
+						},
+					},
+					{
+						ID:      "security-hardened-k8s",
+						Name:    "Security Hardened Kubernetes",
+						Version: "v0.1.0",
+						Rules:   []report.Rule{{ID: "2000", Name: "Rule 2000"}},
+					},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		rep = &report.Report{
+			Time:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DikiVersion: "1",
+			Providers: []report.Provider{
+				stigProvider("provider-foo"),
+				{
+					ID:   "provider-bar",
+					Name: "Provider Bar",
+					Rulesets: []report.Ruleset{
+						{ID: "security-hardened-shoot-cluster", Name: "Security Hardened Shoot Cluster", Version: "v0.1.0"},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#STIGProviders", func() {
+		It("should return only providers with DISA STIG results", func() {
+			Expect(report.STIGProviders(rep)).To(Equal([]string{"provider-foo"}))
+		})
+	})
+
+	Describe("#CKLRenderer", func() {
+		It("should render a checklist for the selected provider", func() {
+			buf := &bytes.Buffer{}
+			Expect(report.NewCKLRenderer("provider-foo").Render(buf, rep)).To(Succeed())
+
+			checklist := cklChecklist{}
+			Expect(xml.Unmarshal(buf.Bytes(), &checklist)).To(Succeed())
+			Expect(checklist.TargetComment).To(Equal("Provider: Provider Foo (provider-foo)\nid: foo"))
+			Expect(checklist.STIGs).To(HaveLen(1))
+			Expect(checklist.STIGs[0].Info).To(ContainElements(
+				HaveField("Data", "Kubernetes_STIG"),
+				HaveField("Data", "Release: 3"),
+			))
+
+			vulns := checklist.STIGs[0].Vulns
+			Expect(vulns).To(HaveLen(4))
+			Expect(vulns[0].Data[0].Data).To(Equal("V-242376"))
+			Expect(vulns[0].Status).To(Equal("NotAFinding"))
+			Expect(vulns[0].FindingDetails).To(Equal("Passed: passed"))
+			Expect(vulns[0].Comments).To(Equal("Accepted: accepted justification"))
+			Expect(vulns[1].Status).To(Equal("Not_Applicable"))
+			Expect(vulns[1].Comments).To(Equal("Skipped: skipped justification"))
+			Expect(vulns[2].Status).To(Equal("Not_Reviewed"))
+			Expect(vulns[3].Data[0].Data).To(Equal("V-242390"))
+			Expect(vulns[3].Data[1].Data).To(Equal("high"))
+			Expect(vulns[3].Status).To(Equal("Open"))
+			Expect(vulns[3].FindingDetails).To(Equal("Passed: passed\n- name: foo\n\nFailed: failed\n- kind: Pod, name: bar"))
+		})
+
+		It("should return error when the report contains multiple providers and none is selected", func() {
+			Expect(report.NewCKLRenderer("").Render(&bytes.Buffer{}, rep)).To(MatchError("checklists are created for a single provider, report contains 2 providers"))
+		})
+
+		It("should return error when the provider does not have DISA STIG results", func() {
+			Expect(report.NewCKLRenderer("provider-bar").Render(&bytes.Buffer{}, rep)).To(MatchError("provider provider-bar does not contain results of ruleset disa-kubernetes-stig"))
+		})
+	})
+
+	Describe("#CKLBRenderer", func() {
+		It("should render a deterministic checklist for a single provider report", func() {
+			rep.Providers = rep.Providers[:1]
+			buf := &bytes.Buffer{}
+			Expect(report.NewCKLBRenderer("").Render(buf, rep)).To(Succeed())
+
+			checklist := cklbChecklist{}
+			Expect(json.Unmarshal(buf.Bytes(), &checklist)).To(Succeed())
+			Expect(checklist.ID).ToNot(BeEmpty())
+			Expect(checklist.STIGs).To(HaveLen(1))
+			Expect(checklist.STIGs[0].Version).To(Equal("2"))
+			Expect(checklist.STIGs[0].ReleaseInfo).To(Equal("Release: 3"))
+
+			statuses := []string{}
+			for _, r := range checklist.STIGs[0].Rules {
+				statuses = append(statuses, r.Status)
+			}
+			Expect(statuses).To(Equal([]string{"not_a_finding", "not_applicable", "not_reviewed", "open"}))
+
+			otherBuf := &bytes.Buffer{}
+			Expect(report.NewCKLBRenderer("").Render(otherBuf, rep)).To(Succeed())
+			Expect(otherBuf.String()).To(Equal(buf.String()))
+		})
+	})
+})
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// Summary contains statistics about the results of rules.
+type Summary struct {
+	// Rules contains the number of rules with checks per status.
+	// Rules with checks of different statuses are counted once per status.
+	Rules map[rule.Status]int `json:"rules"`
+	// Targets contains the number of check targets per status.
+	// Checks without targets are counted once.
+	Targets map[rule.Status]int `json:"targets"`
+	// Severities contains the number of failed check targets per rule severity.
+	// Failed check targets of rules without severity are not counted.
+	Severities map[rule.SeverityLevel]int `json:"severities,omitempty"`
+	// ComplianceScore is the weighted percentage of compliant rules.
+	// It is not set if no rule was evaluated.
+	ComplianceScore *float64 `json:"complianceScore,omitempty"`
+}
+
+// ScoreWeights are the weights of rules per severity used to calculate the compliance score.
+// Rules without severity or with a severity without weight have a weight of 1.
+type ScoreWeights map[rule.SeverityLevel]int
+
+// ApplyToReport implements ReportOption.
+func (sw ScoreWeights) ApplyToReport(opts *ReportOptions) {
+	opts.ScoreWeights = maps.Clone(sw)
+}
+
+// DefaultScoreWeights returns the default weights of rules per severity.
+func DefaultScoreWeights() ScoreWeights {
+	return ScoreWeights{
+		rule.SeverityHigh:   10,
+		rule.SeverityMedium: 5,
+		rule.SeverityLow:    1,
+	}
+}
+
+// ValidateScoreWeights validates that the weights are set for supported severities and are not negative.
+func ValidateScoreWeights(weights ScoreWeights) error {
+	for _, severity := range slices.Sorted(maps.Keys(weights)) {
+		if !slices.Contains(rule.Severities(), severity) {
+			return fmt.Errorf("score weight set for not supported severity %s", severity)
+		}
+		if weights[severity] < 0 {
+			return fmt.Errorf("score weight for severity %s must not be negative", severity)
+		}
+	}
+	return nil
+}
+
+type summaryBuilder struct {
+	summary         Summary
+	weights         ScoreWeights
+	evaluatedWeight int
+	compliantWeight int
+}
+
+func newSummaryBuilder(weights ScoreWeights) *summaryBuilder {
+	return &summaryBuilder{
+		summary: Summary{
+			Rules:      map[rule.Status]int{},
+			Targets:    map[rule.Status]int{},
+			Severities: map[rule.SeverityLevel]int{},
+		},
+		weights: weights,
+	}
+}
+
+// addRule adds the results of a rule to the summary. A rule is evaluated if it has checks that are neither
+// [rule.Skipped] nor [rule.NotImplemented]. An evaluated rule is compliant if all of these checks are
+// [rule.Passed] or [rule.Accepted].
+func (b *summaryBuilder) addRule(r Rule) {
+	var (
+		statuses  = map[rule.Status]struct{}{}
+		evaluated bool
+		compliant = true
+	)
+	for _, check := range r.Checks {
+		targets := len(checkTargets(check))
+		statuses[check.Status] = struct{}{}
+		b.summary.Targets[check.Status] += targets
+		if check.Status == rule.Failed && len(r.Severity) > 0 {
+			b.summary.Severities[r.Severity] += targets
+		}
+
+		switch check.Status {
+		case rule.Skipped, rule.NotImplemented:
+		case rule.Passed, rule.Accepted:
+			evaluated = true
+		default:
+			evaluated = true
+			compliant = false
+		}
+	}
+	for status := range statuses {
+		b.summary.Rules[status]++
+	}
+
+	if !evaluated {
+		return
+	}
+	weight, ok := b.weights[r.Severity]
+	if !ok {
+		weight = 1
+	}
+	b.evaluatedWeight += weight
+	if compliant {
+		b.compliantWeight += weight
+	}
+}
+
+func (b *summaryBuilder) add(other *summaryBuilder) {
+	for status, num := range other.summary.Rules {
+		b.summary.Rules[status] += num
+	}
+	for status, num := range other.summary.Targets {
+		b.summary.Targets[status] += num
+	}
+	for severity, num := range other.summary.Severities {
+		b.summary.Severities[severity] += num
+	}
+	b.evaluatedWeight += other.evaluatedWeight
+	b.compliantWeight += other.compliantWeight
+}
+
+func (b *summaryBuilder) build() *Summary {
+	summary := b.summary
+	if b.evaluatedWeight > 0 {
+		score := math.Round(float64(b.compliantWeight)/float64(b.evaluatedWeight)*10000) / 100
+		summary.ComplianceScore = &score
+	}
+	return &summary
+}
+
+// SetSummary sets the summaries of the report, its providers and rulesets.
+// The summaries are calculated from the checks contained in the report.
+// If no weights are passed the [DefaultScoreWeights] are used.
+func (r *Report) SetSummary(weights ScoreWeights) {
+	if weights == nil {
+		weights = DefaultScoreWeights()
+	}
+
+	reportSummary := newSummaryBuilder(weights)
+	for providerIdx, provider := range r.Providers {
+		providerSummary := newSummaryBuilder(weights)
+		for rulesetIdx, ruleset := range provider.Rulesets {
+			rulesetSummary := newSummaryBuilder(weights)
+			for _, rule := range ruleset.Rules {
+				rulesetSummary.addRule(rule)
+			}
+			provider.Rulesets[rulesetIdx].Summary = rulesetSummary.build()
+			providerSummary.add(rulesetSummary)
+		}
+		r.Providers[providerIdx].Summary = providerSummary.build()
+		reportSummary.add(providerSummary)
+	}
+	r.Summary = reportSummary.build()
+}
+
+// complianceScoreText returns the compliance score of a summary as percentage.
+func complianceScoreText(summary *Summary) string {
+	if summary == nil || summary.ComplianceScore == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.2f%%", *summary.ComplianceScore)
+}
+
+// targetSummaryText returns a summary string with the number of check targets per status.
+func targetSummaryText(summary *Summary) string {
+	var texts []string
+	for _, status := range rule.Statuses() {
+		if num := summary.Targets[status]; num != 0 {
+			texts = append(texts, fmt.Sprintf("%dx %s %c", num, status, rule.StatusIcon(status)))
+		}
+	}
+	return strings.Join(texts, ", ")
+}
+
+// severitySummaryText returns a summary string with the number of failed check targets per severity
+// ordered from highest to lowest severity.
+func severitySummaryText(summary *Summary) string {
+	var texts []string
+	for _, severity := range slices.Backward(rule.Severities()) {
+		if num := summary.Severities[severity]; num != 0 {
+			texts = append(texts, fmt.Sprintf("%dx %s", num, severity))
+		}
+	}
+	return strings.Join(texts, ", ")
+}
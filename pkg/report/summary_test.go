@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("summary", func() {
+	var (
+		rep   *report.Report
+		score = func(s float64) *float64 { return &s }
+	)
+
+	BeforeEach(func() {
+		rep = &report.Report{
+			Providers: []report.Provider{
+				{
+					ID: "provider-foo",
+					Rulesets: []report.Ruleset{
+						{
+							ID: "ruleset-foo",
+							Rules: []report.Rule{
+								{ID: "1", Severity: rule.SeverityHigh, Checks: []report.Check{
+									{Status: rule.Passed, Targets: []rule.Target{rule.NewTarget("name", "foo"), rule.NewTarget("name", "bar")}},
+									{Status: rule.Skipped},
+								}},
+								{ID: "2", Severity: rule.SeverityMedium, Checks: []report.Check{
+									{Status: rule.Failed, Targets: []rule.Target{rule.NewTarget("name", "foo")}},
+									{Status: rule.Accepted},
+								}},
+								{ID: "3", Checks: []report.Check{
+									{Status: rule.NotImplemented},
+								}},
+							},
+						},
+						{
+							ID: "ruleset-bar",
+							Rules: []report.Rule{
+								{ID: "1", Checks: []report.Check{
+									{Status: rule.Accepted},
+								}},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#SetSummary", func() {
+		It("should set the summaries with the default weights", func() {
+			rep.SetSummary(nil)
+
+			Expect(rep.Providers[0].Rulesets[0].Summary).To(Equal(&report.Summary{
+				Rules:           map[rule.Status]int{rule.Passed: 1, rule.Skipped: 1, rule.Failed: 1, rule.Accepted: 1, rule.NotImplemented: 1},
+				Targets:         map[rule.Status]int{rule.Passed: 2, rule.Skipped: 1, rule.Failed: 1, rule.Accepted: 1, rule.NotImplemented: 1},
+				Severities:      map[rule.SeverityLevel]int{rule.SeverityMedium: 1},
+				ComplianceScore: score(66.67),
+			}))
+			Expect(rep.Providers[0].Rulesets[1].Summary.ComplianceScore).To(Equal(score(100)))
+			Expect(rep.Providers[0].Summary.ComplianceScore).To(Equal(score(68.75)))
+			Expect(rep.Providers[0].Summary.Rules).To(HaveKeyWithValue(rule.Accepted, 2))
+			Expect(rep.Summary).To(Equal(rep.Providers[0].Summary))
+		})
+
+		It("should use the given weights", func() {
+			rep.SetSummary(report.ScoreWeights{rule.SeverityHigh: 1})
+
+			Expect(rep.Providers[0].Rulesets[0].Summary.ComplianceScore).To(Equal(score(50)))
+		})
+
+		It("should not set a compliance score when no rule was evaluated", func() {
+			rep.Providers[0].Rulesets = rep.Providers[0].Rulesets[:1]
+			rep.Providers[0].Rulesets[0].Rules = rep.Providers[0].Rulesets[0].Rules[2:]
+			rep.SetSummary(nil)
+
+			Expect(rep.Summary.ComplianceScore).To(BeNil())
+		})
+	})
+
+	Describe("#ValidateScoreWeights", func() {
+		It("should accept weights of supported severities", func() {
+			Expect(report.ValidateScoreWeights(report.DefaultScoreWeights())).To(Succeed())
+		})
+
+		It("should return errors for invalid weights", func() {
+			Expect(report.ValidateScoreWeights(report.ScoreWeights{"foo": 1})).To(MatchError("score weight set for not supported severity foo"))
+			Expect(report.ValidateScoreWeights(report.ScoreWeights{rule.SeverityLow: -1})).To(MatchError("score weight for severity Low must not be negative"))
+		})
+	})
+})
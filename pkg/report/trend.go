@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// TrendReport contains the development of the results of multiple reports over time.
+type TrendReport struct {
+	From      time.Time       `json:"from"`
+	To        time.Time       `json:"to"`
+	Providers []ProviderTrend `json:"providers"`
+}
+
+// ProviderTrend contains the development of the results of a provider over time.
+type ProviderTrend struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Rulesets []RulesetTrend `json:"rulesets"`
+}
+
+// RulesetTrend contains the development of the results of a ruleset over time.
+// Results of different ruleset versions are part of the same trend.
+type RulesetTrend struct {
+	ID     string       `json:"id"`
+	Name   string       `json:"name"`
+	Points []TrendPoint `json:"points"`
+	// RemediatedFindings is the number of failed check targets that are not failed in a later report.
+	RemediatedFindings int `json:"remediatedFindings"`
+	// MeanTimeToRemediate is the mean duration between the first report that contains a remediated
+	// finding and the first later report that does not contain it.
+	MeanTimeToRemediate *metav1.Duration `json:"meanTimeToRemediate,omitempty"`
+}
+
+// TrendPoint contains the results of a ruleset in a single report.
+type TrendPoint struct {
+	Time    time.Time `json:"time"`
+	Version string    `json:"version"`
+	// Statuses contains the number of check targets per status.
+	Statuses map[rule.Status]int `json:"statuses"`
+	// Severities contains the number of failed check targets per rule severity.
+	// Failed check targets of rules without severity are not counted.
+	Severities map[rule.SeverityLevel]int `json:"severities,omitempty"`
+	// NewlyFailingRules contains the IDs of the rules with failed checks that
+	// did not have failed checks in the previous report.
+	NewlyFailingRules []string `json:"newlyFailingRules,omitempty"`
+}
+
+type rulesetTrendState struct {
+	trend         *RulesetTrend
+	failingRules  map[string]struct{}
+	openFindings  map[string]time.Time
+	remediateTime time.Duration
+}
+
+// CreateTrend creates a trend report from the given reports.
+// The reports are ordered by their creation time.
+func CreateTrend(reports []*Report) *TrendReport {
+	sortedReports := slices.Clone(reports)
+	slices.SortStableFunc(sortedReports, func(a, b *Report) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	var (
+		trend     = &TrendReport{}
+		providers = map[string]*ProviderTrend{}
+		rulesets  = map[string]map[string]*rulesetTrendState{}
+	)
+	if len(sortedReports) > 0 {
+		trend.From = sortedReports[0].Time
+		trend.To = sortedReports[len(sortedReports)-1].Time
+	}
+
+	for _, report := range sortedReports {
+		for _, provider := range report.Providers {
+			if _, ok := providers[provider.ID]; !ok {
+				providers[provider.ID] = &ProviderTrend{ID: provider.ID}
+				rulesets[provider.ID] = map[string]*rulesetTrendState{}
+			}
+			providers[provider.ID].Name = provider.Name
+
+			for _, ruleset := range provider.Rulesets {
+				state, ok := rulesets[provider.ID][ruleset.ID]
+				if !ok {
+					state = &rulesetTrendState{
+						trend:        &RulesetTrend{ID: ruleset.ID},
+						openFindings: map[string]time.Time{},
+					}
+					rulesets[provider.ID][ruleset.ID] = state
+				}
+				state.trend.Name = ruleset.Name
+				state.addPoint(report.Time, ruleset)
+			}
+		}
+	}
+
+	for _, providerID := range slices.Sorted(maps.Keys(providers)) {
+		provider := providers[providerID]
+		for _, rulesetID := range slices.Sorted(maps.Keys(rulesets[providerID])) {
+			state := rulesets[providerID][rulesetID]
+			if state.trend.RemediatedFindings > 0 {
+				mean := (state.remediateTime / time.Duration(state.trend.RemediatedFindings)).Round(time.Second)
+				state.trend.MeanTimeToRemediate = &metav1.Duration{Duration: mean}
+			}
+			provider.Rulesets = append(provider.Rulesets, *state.trend)
+		}
+		trend.Providers = append(trend.Providers, *provider)
+	}
+	return trend
+}
+
+func (s *rulesetTrendState) addPoint(reportTime time.Time, ruleset Ruleset) {
+	var (
+		point = TrendPoint{
+			Time:       reportTime,
+			Version:    ruleset.Version,
+			Statuses:   map[rule.Status]int{},
+			Severities: map[rule.SeverityLevel]int{},
+		}
+		failingRules = map[string]struct{}{}
+		findings     = map[string]struct{}{}
+	)
+
+	for _, r := range ruleset.Rules {
+		for _, check := range r.Checks {
+			targets := checkTargets(check)
+			point.Statuses[check.Status] += len(targets)
+			if check.Status != rule.Failed {
+				continue
+			}
+
+			failingRules[r.ID] = struct{}{}
+			if len(r.Severity) > 0 {
+				point.Severities[r.Severity] += len(targets)
+			}
+			for _, target := range targets {
+				findings[r.ID+"\x00"+canonicalTarget(target)] = struct{}{}
+			}
+		}
+	}
+
+	if s.failingRules != nil {
+		for ruleID := range failingRules {
+			if _, ok := s.failingRules[ruleID]; !ok {
+				point.NewlyFailingRules = append(point.NewlyFailingRules, ruleID)
+			}
+		}
+		slices.Sort(point.NewlyFailingRules)
+	}
+	s.failingRules = failingRules
+
+	for finding, firstSeen := range s.openFindings {
+		if _, ok := findings[finding]; !ok {
+			s.trend.RemediatedFindings++
+			s.remediateTime += reportTime.Sub(firstSeen)
+			delete(s.openFindings, finding)
+		}
+	}
+	for finding := range findings {
+		if _, ok := s.openFindings[finding]; !ok {
+			s.openFindings[finding] = reportTime
+		}
+	}
+
+	s.trend.Points = append(s.trend.Points, point)
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("trend", func() {
+	var (
+		firstTime  = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		secondTime = firstTime.Add(24 * time.Hour)
+		thirdTime  = firstTime.Add(72 * time.Hour)
+
+		newReport = func(reportTime time.Time, version string, rules ...report.Rule) *report.Report {
+			return &report.Report{
+				Time:        reportTime,
+				DikiVersion: "1",
+				Providers: []report.Provider{
+					{
+						ID:   "provider-foo",
+						Name: "Provider Foo",
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Name:    "Ruleset Foo",
+								Version: version,
+								Rules:   rules,
+							},
+						},
+					},
+				},
+			}
+		}
+		reports []*report.Report
+	)
+
+	BeforeEach(func() {
+		reports = []*report.Report{
+			newReport(thirdTime, "v2",
+				report.Rule{ID: "1", Severity: rule.SeverityHigh, Checks: []report.Check{
+					{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "foo"), rule.NewTarget("name", "bar")}},
+				}},
+				report.Rule{ID: "2", Checks: []report.Check{
+					{Status: rule.Failed, Message: "failed"},
+				}},
+			),
+			newReport(firstTime, "v1",
+				report.Rule{ID: "1", Severity: rule.SeverityHigh, Checks: []report.Check{
+					{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "foo"), rule.NewTarget("name", "bar")}},
+				}},
+				report.Rule{ID: "2", Checks: []report.Check{
+					{Status: rule.Passed, Message: "passed"},
+				}},
+			),
+			newReport(secondTime, "v1",
+				report.Rule{ID: "1", Severity: rule.SeverityHigh, Checks: []report.Check{
+					{Status: rule.Passed, Message: "passed", Targets: []rule.Target{rule.NewTarget("name", "foo")}},
+					{Status: rule.Failed, Message: "failed", Targets: []rule.Target{rule.NewTarget("name", "bar")}},
+				}},
+				report.Rule{ID: "2", Severity: rule.SeverityLow, Checks: []report.Check{
+					{Status: rule.Errored, Message: "errored"},
+				}},
+			),
+		}
+	})
+
+	Describe("#CreateTrend", func() {
+		It("should create a trend ordered by report time", func() {
+			trend := report.CreateTrend(reports)
+
+			Expect(trend.From).To(Equal(firstTime))
+			Expect(trend.To).To(Equal(thirdTime))
+			Expect(trend.Providers).To(HaveLen(1))
+			Expect(trend.Providers[0].ID).To(Equal("provider-foo"))
+			Expect(trend.Providers[0].Rulesets).To(Equal([]report.RulesetTrend{
+				{
+					ID:   "ruleset-foo",
+					Name: "Ruleset Foo",
+					Points: []report.TrendPoint{
+						{
+							Time:       firstTime,
+							Version:    "v1",
+							Statuses:   map[rule.Status]int{rule.Failed: 2, rule.Passed: 1},
+							Severities: map[rule.SeverityLevel]int{rule.SeverityHigh: 2},
+						},
+						{
+							Time:       secondTime,
+							Version:    "v1",
+							Statuses:   map[rule.Status]int{rule.Passed: 1, rule.Failed: 1, rule.Errored: 1},
+							Severities: map[rule.SeverityLevel]int{rule.SeverityHigh: 1},
+						},
+						{
+							Time:              thirdTime,
+							Version:           "v2",
+							Statuses:          map[rule.Status]int{rule.Passed: 2, rule.Failed: 1},
+							Severities:        map[rule.SeverityLevel]int{},
+							NewlyFailingRules: []string{"2"},
+						},
+					},
+					RemediatedFindings:  2,
+					MeanTimeToRemediate: &metav1.Duration{Duration: 48 * time.Hour},
+				},
+			}))
+		})
+
+		It("should not set a mean time to remediate when no findings were remediated", func() {
+			trend := report.CreateTrend(reports[1:2])
+
+			Expect(trend.Providers[0].Rulesets[0].Points).To(HaveLen(1))
+			Expect(trend.Providers[0].Rulesets[0].RemediatedFindings).To(BeZero())
+			Expect(trend.Providers[0].Rulesets[0].MeanTimeToRemediate).To(BeNil())
+		})
+	})
+
+	Describe("#Render", func() {
+		It("should render the trend report as html", func() {
+			renderer, err := report.NewHTMLRenderer()
+			Expect(err).ToNot(HaveOccurred())
+
+			buf := &bytes.Buffer{}
+			Expect(renderer.Render(buf, report.CreateTrend(reports))).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("Trend report"))
+			Expect(buf.String()).To(ContainSubstring("Mean time to remediate: 48h0m0s"))
+		})
+	})
+})
@@ -15,6 +15,11 @@ func Result(r Rule, checkResults ...CheckResult) RuleResult {
 	if severity, ok := r.(Severity); ok {
 		result.Severity = severity.Severity()
 	}
+
+	if documented, ok := r.(Documented); ok {
+		documentation := documented.Documentation()
+		result.Documentation = &documentation
+	}
 	return result
 }
 
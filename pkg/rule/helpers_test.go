@@ -27,6 +27,14 @@ var _ = Describe("utils", func() {
 				},
 			}))
 		})
+
+		It("should set the documentation of documented rules", func() {
+			result := rule.Result(&fakeDocumentedRule{}, rule.CheckResult{Status: rule.Passed, Message: "foo", Target: rule.Target{}})
+			Expect(result.Documentation).To(Equal(&rule.Documentation{
+				Description: "foo",
+				References:  []rule.Reference{{Type: rule.ReferenceSTIGVulnID, ID: "V-1"}},
+			}))
+		})
 	})
 
 	DescribeTable("#GetCheckResult",
@@ -94,3 +102,14 @@ func (*fakeRule) Name() string {
 func (*fakeRule) Run(context.Context) (rule.RuleResult, error) {
 	return rule.RuleResult{}, nil
 }
+
+type fakeDocumentedRule struct {
+	fakeRule
+}
+
+func (*fakeDocumentedRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Description: "foo",
+		References:  []rule.Reference{{Type: rule.ReferenceSTIGVulnID, ID: "V-1"}},
+	}
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Conformance runs a plugin executable with the given request and checks that it conforms to the plugin protocol.
+// It is meant to be used in the tests of plugin authors, e.g.
+//
+//	Expect(plugin.Conformance(ctx, "./bin/my-plugin", plugin.Request{RuleID: "my-rule"})).To(Succeed())
+//
+// In addition to the validation done when Diki runs a plugin, the response must be a single JSON object
+// without unknown fields and the targets of check results must not contain empty keys.
+func Conformance(ctx context.Context, command string, request Request) error {
+	stdout, err := run(ctx, command, request)
+	if err != nil {
+		return err
+	}
+
+	var (
+		response Response
+		decoder  = json.NewDecoder(bytes.NewReader(stdout))
+	)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&response); err != nil {
+		return fmt.Errorf("plugin returned an invalid response: %w", err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("plugin returned an invalid response: unexpected data after the response")
+	}
+
+	errs := []error{response.Validate(request)}
+	for i, checkResult := range response.CheckResults {
+		if _, ok := checkResult.Target[""]; ok {
+			errs = append(errs, fmt.Errorf("check result %d has a target with an empty key", i))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("plugin returned an invalid response: %w", err)
+	}
+	return nil
+}
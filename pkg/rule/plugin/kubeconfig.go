@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const kubeconfigContext = "diki"
+
+// WriteKubeconfig writes a kubeconfig file with the server and credentials of a rest config to the given path.
+// Settings that cannot be expressed in a kubeconfig, like custom transports, are not included.
+func WriteKubeconfig(config *rest.Config, path string) error {
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters[kubeconfigContext] = &clientcmdapi.Cluster{
+		Server:                   config.Host,
+		TLSServerName:            config.ServerName,
+		InsecureSkipTLSVerify:    config.Insecure,
+		CertificateAuthority:     config.CAFile,
+		CertificateAuthorityData: config.CAData,
+	}
+	kubeconfig.AuthInfos[kubeconfigContext] = &clientcmdapi.AuthInfo{
+		ClientCertificate:     config.CertFile,
+		ClientCertificateData: config.CertData,
+		ClientKey:             config.KeyFile,
+		ClientKeyData:         config.KeyData,
+		Token:                 config.BearerToken,
+		TokenFile:             config.BearerTokenFile,
+		Impersonate:           config.Impersonate.UserName,
+		ImpersonateUID:        config.Impersonate.UID,
+		ImpersonateGroups:     config.Impersonate.Groups,
+		ImpersonateUserExtra:  config.Impersonate.Extra,
+		Username:              config.Username,
+		Password:              config.Password,
+		AuthProvider:          config.AuthProvider,
+		Exec:                  config.ExecProvider,
+	}
+	kubeconfig.Contexts[kubeconfigContext] = &clientcmdapi.Context{
+		Cluster:  kubeconfigContext,
+		AuthInfo: kubeconfigContext,
+	}
+	kubeconfig.CurrentContext = kubeconfigContext
+
+	return clientcmd.WriteToFile(*kubeconfig, path)
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/plugin"
+)
+
+var _ = Describe("plugin", func() {
+	var (
+		ctx = context.TODO()
+		dir string
+
+		writePlugin = func(script string) string {
+			path := filepath.Join(dir, "plugin.sh")
+			Expect(os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0700)).To(Succeed())
+			return path
+		}
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	Describe("#Rule", func() {
+		It("should pass the request and return the check results of the plugin", func() {
+			command := writePlugin(`cat > "` + dir + `/request.json"
+cp "$KUBECONFIG" "` + dir + `/kubeconfig"
+echo '{"ruleID":"foo","checkResults":[{"status":"Passed","message":"foo"},{"status":"Failed","message":"bar","target":{"name":"bar"}}]}'
+`)
+			r := plugin.NewRule(config.PluginConfig{
+				ID:       "foo",
+				Name:     "Foo",
+				Severity: "High",
+				Command:  command,
+				Args:     map[string]any{"foo": "bar"},
+			}, &rest.Config{Host: "https://foo.bar", BearerToken: "token"})
+
+			result, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(rule.RuleResult{
+				RuleID:   "foo",
+				RuleName: "Foo",
+				Severity: rule.SeverityHigh,
+				CheckResults: []rule.CheckResult{
+					rule.PassedCheckResult("foo", rule.NewTarget()),
+					rule.FailedCheckResult("bar", rule.NewTarget("name", "bar")),
+				},
+			}))
+
+			requestBytes, err := os.ReadFile(filepath.Join(dir, "request.json"))
+			Expect(err).ToNot(HaveOccurred())
+			var request plugin.Request
+			Expect(json.Unmarshal(requestBytes, &request)).To(Succeed())
+			Expect(request.RuleID).To(Equal("foo"))
+			Expect(request.Args).To(Equal(map[string]any{"foo": "bar"}))
+			Expect(request.KubeconfigPath).ToNot(BeAnExistingFile())
+
+			restConfig, err := clientcmd.BuildConfigFromFlags("", filepath.Join(dir, "kubeconfig"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restConfig.Host).To(Equal("https://foo.bar"))
+			Expect(restConfig.BearerToken).To(Equal("token"))
+		})
+
+		It("should return an errored retryable check when the plugin execution fails", func() {
+			command := writePlugin(`echo "foo failed" >&2
+exit 1
+`)
+			result, err := plugin.NewRule(config.PluginConfig{ID: "foo", Command: command}, nil).Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{
+				rule.ErroredCheckResult("plugin execution failed: exit status 1: foo failed", rule.NewTarget()),
+			}))
+			Expect(plugin.RetryCondition(result)).To(BeTrue())
+		})
+
+		It("should return an errored check that is not retryable when the plugin response is invalid", func() {
+			command := writePlugin(`echo '{"ruleID":"bar","checkResults":[{"status":"Foo"}]}'`)
+			result, err := plugin.NewRule(config.PluginConfig{ID: "foo", Command: command}, nil).Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{
+				rule.ErroredCheckResult("plugin returned an invalid response: rule id bar does not match requested rule id foo\ncheck result 0 has unknown status \"Foo\"\ncheck result 0 has an empty message", rule.NewTarget()),
+			}))
+			Expect(plugin.RetryCondition(result)).To(BeFalse())
+		})
+
+		It("should stop the plugin when the context is done", func() {
+			command := writePlugin("exec sleep 10")
+			timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+
+			result, err := plugin.NewRule(config.PluginConfig{ID: "foo", Command: command}, nil).Run(timeoutCtx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{
+				rule.ErroredCheckResult("plugin execution failed: context deadline exceeded", rule.NewTarget()),
+			}))
+		})
+	})
+
+	Describe("#Conformance", func() {
+		It("should succeed for conforming plugins", func() {
+			command := writePlugin(`echo '{"checkResults":[{"status":"Passed","message":"foo","target":{"name":"foo"}}]}'`)
+			Expect(plugin.Conformance(ctx, command, plugin.Request{RuleID: "foo"})).To(Succeed())
+		})
+
+		DescribeTable("should fail for plugins that do not conform",
+			func(response, expectedErr string) {
+				command := writePlugin("echo '" + response + "'")
+				Expect(plugin.Conformance(ctx, command, plugin.Request{RuleID: "foo"})).To(MatchError(expectedErr))
+			},
+			Entry("no check results", `{"checkResults":[]}`, "plugin returned an invalid response: no check results returned"),
+			Entry("unknown fields", `{"foo":"bar","checkResults":[]}`, `plugin returned an invalid response: json: unknown field "foo"`),
+			Entry("trailing data", `{"checkResults":[{"status":"Passed","message":"foo"}]} {}`, "plugin returned an invalid response: unexpected data after the response"),
+			Entry("empty target keys", `{"checkResults":[{"status":"Passed","message":"foo","target":{"":"foo"}}]}`, "plugin returned an invalid response: check result 0 has a target with an empty key"),
+		)
+	})
+})
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	// KubeconfigEnvVar is the environment variable that is set to the kubeconfig path
+	// of the request when a plugin executable is run.
+	KubeconfigEnvVar = "KUBECONFIG"
+
+	// executionErrorPrefix is the prefix of the messages of [ExecutionError]s.
+	executionErrorPrefix = "plugin execution failed: "
+	// maxStderrLength is the max number of stderr bytes of a plugin executable included in errors.
+	maxStderrLength = 1024
+	// waitDelay is the time given to a plugin executable to close its output after its context is done.
+	waitDelay = 5 * time.Second
+)
+
+// Request is written as JSON to the standard input of a plugin executable.
+type Request struct {
+	// RuleID is the id of the rule that the plugin executable should run.
+	RuleID string `json:"ruleID"`
+	// Args are the rule specific arguments of the plugin configuration.
+	Args any `json:"args,omitempty"`
+	// KubeconfigPath is the path to a kubeconfig file for the evaluated cluster.
+	KubeconfigPath string `json:"kubeconfigPath,omitempty"`
+}
+
+// Response is read as JSON from the standard output of a plugin executable.
+// It has the shape of a [rule.RuleResult], while the rule name and severity are taken from the plugin configuration.
+type Response struct {
+	// RuleID is the id of the rule that was run. It is optional, but must match the requested rule id if set.
+	RuleID       string        `json:"ruleID,omitempty"`
+	CheckResults []CheckResult `json:"checkResults"`
+}
+
+// CheckResult is the result of a single check of a plugin executable.
+type CheckResult struct {
+	Status  rule.Status `json:"status"`
+	Message string      `json:"message"`
+	Target  rule.Target `json:"target,omitempty"`
+}
+
+// Validate validates that the response is a valid answer to the given request.
+func (r Response) Validate(request Request) error {
+	var errs []error
+
+	if len(r.RuleID) > 0 && r.RuleID != request.RuleID {
+		errs = append(errs, fmt.Errorf("rule id %s does not match requested rule id %s", r.RuleID, request.RuleID))
+	}
+	if len(r.CheckResults) == 0 {
+		errs = append(errs, errors.New("no check results returned"))
+	}
+	for i, checkResult := range r.CheckResults {
+		if !slices.Contains(rule.Statuses(), checkResult.Status) {
+			errs = append(errs, fmt.Errorf("check result %d has unknown status %q", i, checkResult.Status))
+		}
+		if len(checkResult.Message) == 0 {
+			errs = append(errs, fmt.Errorf("check result %d has an empty message", i))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ExecutionError is returned when a plugin executable could not be run or did not exit successfully.
+type ExecutionError struct {
+	Err    error
+	Stderr string
+}
+
+func (e *ExecutionError) Error() string {
+	msg := executionErrorPrefix + e.Err.Error()
+	if len(e.Stderr) > 0 {
+		msg += ": " + e.Stderr
+	}
+	return msg
+}
+
+func (e *ExecutionError) Unwrap() error {
+	return e.Err
+}
+
+// Execute runs a plugin executable with the given request and returns its validated response.
+// The executable is killed when the context is done.
+func Execute(ctx context.Context, command string, request Request) (*Response, error) {
+	stdout, err := run(ctx, command, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response Response
+	if err := json.Unmarshal(stdout, &response); err != nil {
+		return nil, fmt.Errorf("plugin returned an invalid response: %w", err)
+	}
+	if err := response.Validate(request); err != nil {
+		return nil, fmt.Errorf("plugin returned an invalid response: %w", err)
+	}
+	return &response, nil
+}
+
+func run(ctx context.Context, command string, request Request) ([]byte, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal plugin request: %w", err)
+	}
+
+	var (
+		stdout, stderr bytes.Buffer
+		cmd            = exec.CommandContext(ctx, command) // #nosec G204 -- the executable is configured by the user
+	)
+	cmd.Stdin = bytes.NewReader(requestBytes)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = waitDelay
+	if len(request.KubeconfigPath) > 0 {
+		cmd.Env = append(os.Environ(), KubeconfigEnvVar+"="+request.KubeconfigPath)
+	}
+
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		return nil, &ExecutionError{Err: err, Stderr: lastBytes(strings.TrimSpace(stderr.String()), maxStderrLength)}
+	}
+	return stdout.Bytes(), nil
+}
+
+func lastBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return "..." + strings.ToValidUTF8(s[len(s)-n:], "")
+}
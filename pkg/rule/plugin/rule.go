@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var (
+	_ rule.Rule     = &Rule{}
+	_ rule.Severity = &Rule{}
+)
+
+// Rule is a [rule.Rule] that is implemented by an external plugin executable.
+// The executable is run with a JSON [Request] on its standard input and
+// has to write a JSON [Response] to its standard output.
+type Rule struct {
+	// Config is used to create the kubeconfig that is passed to the plugin executable.
+	// No kubeconfig is passed if it is nil.
+	Config   *rest.Config
+	id       string
+	name     string
+	severity rule.SeverityLevel
+	command  string
+	args     any
+}
+
+// NewRule creates a [Rule] from a plugin configuration.
+func NewRule(pluginConfig config.PluginConfig, restConfig *rest.Config) *Rule {
+	return &Rule{
+		Config:   restConfig,
+		id:       pluginConfig.ID,
+		name:     pluginConfig.Name,
+		severity: rule.SeverityLevel(pluginConfig.Severity),
+		command:  pluginConfig.Command,
+		args:     pluginConfig.Args,
+	}
+}
+
+func (r *Rule) ID() string {
+	return r.id
+}
+
+func (r *Rule) Name() string {
+	return r.name
+}
+
+func (r *Rule) Severity() rule.SeverityLevel {
+	return r.severity
+}
+
+func (r *Rule) Run(ctx context.Context) (rule.RuleResult, error) {
+	request := Request{
+		RuleID: r.id,
+		Args:   r.args,
+	}
+
+	if r.Config != nil {
+		dir, err := os.MkdirTemp("", "diki-plugin-")
+		if err != nil {
+			return rule.Result(r, rule.ErroredCheckResult(err.Error(), rule.NewTarget())), nil
+		}
+		defer os.RemoveAll(dir)
+
+		request.KubeconfigPath = filepath.Join(dir, "kubeconfig")
+		if err := WriteKubeconfig(r.Config, request.KubeconfigPath); err != nil {
+			return rule.Result(r, rule.ErroredCheckResult(err.Error(), rule.NewTarget())), nil
+		}
+	}
+
+	response, err := Execute(ctx, r.command, request)
+	if err != nil {
+		return rule.Result(r, rule.ErroredCheckResult(err.Error(), rule.NewTarget())), nil
+	}
+
+	checkResults := make([]rule.CheckResult, 0, len(response.CheckResults))
+	for _, checkResult := range response.CheckResults {
+		target := checkResult.Target
+		if target == nil {
+			target = rule.NewTarget()
+		}
+		checkResults = append(checkResults, rule.CheckResult{
+			Status:  checkResult.Status,
+			Message: checkResult.Message,
+			Target:  target,
+		})
+	}
+	return rule.Result(r, checkResults...), nil
+}
+
+// RetryCondition returns true if a rule result contains an errored check caused by a failed plugin execution.
+// Invalid responses of plugin executables are not retried.
+func RetryCondition(ruleResult rule.RuleResult) bool {
+	for _, checkResult := range ruleResult.CheckResults {
+		if checkResult.Status == rule.Errored && strings.HasPrefix(checkResult.Message, executionErrorPrefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -34,6 +34,16 @@ func WithRetryCondition(retryCondition func(ruleResult rule.RuleResult) bool) Cr
 	}
 }
 
+// WithBackoff sets the Backoff of a [RetryableRule].
+func WithBackoff(backoff Backoff) CreateOption {
+	return func(rr *RetryableRule) {
+		if backoff.Base < 0 || backoff.Cap < 0 || backoff.Jitter < 0 {
+			panic("backoff should not contain negative values")
+		}
+		rr.Backoff = backoff
+	}
+}
+
 // WithLogger the logger of a [RetryableRule].
 func WithLogger(logger Logger) CreateOption {
 	return func(rr *RetryableRule) {
@@ -8,7 +8,7 @@ import (
 	"context"
 	"io"
 	"log/slog"
-	"math"
+	"math/rand/v2"
 	"regexp"
 	"time"
 
@@ -30,15 +30,53 @@ type RetryableRule struct {
 	BaseRule       rule.Rule
 	MaxRetries     int
 	RetryCondition func(ruleResult rule.RuleResult) bool
+	Backoff        Backoff
 	Logger         Logger
 }
 
+// Backoff describes the waits between the runs of a [RetryableRule].
+type Backoff struct {
+	// Base is the wait before the first retry. It is doubled for every retry after the first ConstantRetries retries.
+	Base time.Duration
+	// ConstantRetries is the number of retries after the first one that wait the base wait before it is doubled.
+	ConstantRetries int
+	// Cap is the max wait before a retry.
+	Cap time.Duration
+	// Jitter is the max fraction of a wait that is randomly added to it.
+	Jitter float64
+}
+
+// DefaultBackoff returns the [Backoff] used when none is configured.
+// It waits 4s before the first three retries and doubles the wait up to 32s afterwards.
+func DefaultBackoff() Backoff {
+	return Backoff{
+		Base:            4 * time.Second,
+		ConstantRetries: 2,
+		Cap:             32 * time.Second,
+	}
+}
+
+// Wait returns the wait before the retry with the given index, starting from 0.
+func (b Backoff) Wait(retry int) time.Duration {
+	wait := b.Base
+	for i := 0; i < retry-b.ConstantRetries && wait < b.Cap; i++ {
+		wait *= 2
+	}
+	wait = min(wait, b.Cap)
+
+	if b.Jitter > 0 {
+		wait += time.Duration(rand.Float64() * b.Jitter * float64(wait)) // #nosec G404 -- jitter does not need a secure random source
+	}
+	return wait
+}
+
 // New creates a new RetryableRule.
 func New(options ...CreateOption) *RetryableRule {
 	handler := slog.NewJSONHandler(io.Discard, nil)
 	rr := &RetryableRule{
 		MaxRetries:     1,
 		RetryCondition: func(_ rule.RuleResult) bool { return false },
+		Backoff:        DefaultBackoff(),
 		Logger:         slog.New(handler),
 	}
 
@@ -72,30 +110,72 @@ func (rr *RetryableRule) Severity() rule.SeverityLevel {
 }
 
 // Run executes the base rule and retries when the retry condition is met and max retries are not reached yet.
+// Waiting for a retry is stopped when the context is done, in which case the result of the last run is returned.
+// The result of a retried rule contains the number of runs and the errors that caused the last retry.
 func (rr *RetryableRule) Run(ctx context.Context) (rule.RuleResult, error) {
 	var (
-		res rule.RuleResult
-		err error
+		res         rule.RuleResult
+		err         error
+		attempts    int
+		retryErrors []string
 	)
 
+retryLoop:
 	for i := 0; i <= rr.MaxRetries; i++ {
 		res, err = rr.BaseRule.Run(ctx)
+		attempts++
 		if !rr.RetryCondition(res) || err != nil {
 			break
 		}
 		if i < rr.MaxRetries {
-			waitDuration := min(math.Pow(2, max(float64(i), 2)), 32)
-
-			rr.Logger.Info("waiting to retry run", "wait_duration_seconds", waitDuration)
-			sleepDuration := time.Duration(waitDuration * float64(time.Second))
-			time.Sleep(sleepDuration)
+			retryErrors = erroredCheckMessages(res)
+			waitDuration := rr.Backoff.Wait(i)
+
+			rr.Logger.Info("waiting to retry run", "wait_duration_seconds", waitDuration.Seconds())
+			timer := time.NewTimer(waitDuration)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				rr.Logger.Info("stopped waiting to retry run", "reason", context.Cause(ctx).Error())
+				break retryLoop
+			case <-timer.C:
+			}
 
 			rr.Logger.Info("retrying run", "retry_attempt", i+1)
 		}
 	}
+
+	if attempts > 1 {
+		res.Retry = &rule.RetryInfo{
+			Attempts: attempts,
+			Errors:   retryErrors,
+		}
+	}
 	return res, err
 }
 
+func erroredCheckMessages(ruleResult rule.RuleResult) []string {
+	var messages []string
+	for _, checkResult := range ruleResult.CheckResults {
+		if checkResult.Status == rule.Errored {
+			messages = append(messages, checkResult.Message)
+		}
+	}
+	return messages
+}
+
+// AnyRetryCondition generates a retry condition func that is met when at least one of the given conditions is met.
+func AnyRetryCondition(retryConditions ...func(ruleResult rule.RuleResult) bool) func(ruleResult rule.RuleResult) bool {
+	return func(ruleResult rule.RuleResult) bool {
+		for _, retryCondition := range retryConditions {
+			if retryCondition(ruleResult) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // RetryConditionFromRegex generates a retry condition func that matches messages from [rule.Errored] statuses.
 func RetryConditionFromRegex(regexes ...regexp.Regexp) func(ruleResult rule.RuleResult) bool {
 	return func(ruleResult rule.RuleResult) bool {
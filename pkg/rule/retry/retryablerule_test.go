@@ -7,6 +7,7 @@ package retry_test
 import (
 	"context"
 	"regexp"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -79,6 +80,91 @@ var _ = Describe("retryablerule", func() {
 			Entry("should not retry when retry condition is not met", falseRetryCondition, 7, 1),
 			Entry("should retry until retry condition is not met", simpleRetryCondition, 7, 2),
 		)
+
+		It("should record the attempts and the errors that caused the last retry", func() {
+			sr := &simpleRule{}
+			rr := retry.New(
+				retry.WithBaseRule(sr),
+				retry.WithMaxRetries(7),
+				retry.WithRetryCondition(simpleRetryCondition),
+				retry.WithBackoff(retry.Backoff{Base: time.Millisecond, Cap: time.Millisecond}),
+				retry.WithLogger(testLogger),
+			)
+
+			res, err := rr.Run(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(res.Retry).To(Equal(&rule.RetryInfo{Attempts: 2, Errors: []string{"foo"}}))
+		})
+
+		It("should not record retries when the rule is run once", func() {
+			sr := &simpleRule{}
+			rr := retry.New(
+				retry.WithBaseRule(sr),
+				retry.WithRetryCondition(falseRetryCondition),
+				retry.WithLogger(testLogger),
+			)
+
+			res, err := rr.Run(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(res.Retry).To(BeNil())
+		})
+
+		It("should stop waiting for a retry when the context is done", func() {
+			sr := &simpleRule{}
+			rr := retry.New(
+				retry.WithBaseRule(sr),
+				retry.WithMaxRetries(3),
+				retry.WithRetryCondition(trueRetryCondition),
+				retry.WithBackoff(retry.Backoff{Base: time.Hour, Cap: time.Hour}),
+				retry.WithLogger(testLogger),
+			)
+
+			cancelCtx, cancel := context.WithCancel(ctx)
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				cancel()
+			}()
+
+			res, err := rr.Run(cancelCtx)
+
+			Expect(err).To(BeNil())
+			Expect(counter).To(Equal(1))
+			Expect(res.CheckResults).To(Equal([]rule.CheckResult{rule.ErroredCheckResult("foo", rule.NewTarget())}))
+		})
+	})
+
+	DescribeTable("#Backoff.Wait",
+		func(backoff retry.Backoff, retryIdx int, expectedWait time.Duration) {
+			Expect(backoff.Wait(retryIdx)).To(Equal(expectedWait))
+		},
+		Entry("should return the base wait for the first retry", retry.DefaultBackoff(), 0, 4*time.Second),
+		Entry("should keep the base wait for the first retries", retry.DefaultBackoff(), 2, 4*time.Second),
+		Entry("should double the wait after the constant retries", retry.DefaultBackoff(), 4, 16*time.Second),
+		Entry("should not exceed the max wait", retry.DefaultBackoff(), 10, 32*time.Second),
+		Entry("should respect a configured backoff", retry.Backoff{Base: time.Second, Cap: time.Minute}, 3, 8*time.Second),
+		Entry("should respect configured constant retries", retry.Backoff{Base: time.Second, ConstantRetries: 1, Cap: time.Minute}, 3, 4*time.Second),
+	)
+
+	It("#Backoff.Wait should add at most the jitter fraction of the wait", func() {
+		backoff := retry.Backoff{Base: time.Second, Cap: time.Minute, Jitter: 0.5}
+		for i := 0; i < 10; i++ {
+			Expect(backoff.Wait(1)).To(BeNumerically(">=", 2*time.Second))
+			Expect(backoff.Wait(1)).To(BeNumerically("<=", 3*time.Second))
+		}
+	})
+
+	Describe("#AnyRetryCondition", func() {
+		It("should be met when at least one condition is met", func() {
+			rc := retry.AnyRetryCondition(func(rule.RuleResult) bool { return false }, func(rule.RuleResult) bool { return true })
+			Expect(rc(rule.RuleResult{})).To(BeTrue())
+		})
+
+		It("should not be met when no condition is met", func() {
+			rc := retry.AnyRetryCondition(func(rule.RuleResult) bool { return false })
+			Expect(rc(rule.RuleResult{})).To(BeFalse())
+		})
 	})
 
 	Describe("#RetryConditionFromRegex", func() {
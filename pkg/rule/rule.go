@@ -22,6 +22,18 @@ type RuleResult struct {
 	RuleID, RuleName string
 	Severity         SeverityLevel
 	CheckResults     []CheckResult
+	// Retry is set when the Rule was run more than once.
+	Retry *RetryInfo
+	// Documentation is set when the Rule is documented.
+	Documentation *Documentation
+}
+
+// RetryInfo contains information about the runs of a retried Rule.
+type RetryInfo struct {
+	// Attempts is the number of times the Rule was run.
+	Attempts int
+	// Errors are the messages of the errored checks that caused the last retry.
+	Errors []string
 }
 
 // SeverityLevel defines the levels that can describe the importance of a Rule.
@@ -36,11 +48,61 @@ const (
 	SeverityHigh SeverityLevel = "High"
 )
 
+// Severities returns all supported severity levels.
+func Severities() []SeverityLevel {
+	return []SeverityLevel{SeverityLow, SeverityMedium, SeverityHigh}
+}
+
+// Less is used to define the priority of the severity levels.
+// The ascending order is as follows
+// Low, Medium, High
+// Unknown severity levels are less than all supported ones.
+func (a SeverityLevel) Less(b SeverityLevel) bool {
+	severities := Severities()
+	return slices.Index(severities, a) < slices.Index(severities, b)
+}
+
 // Severity defines the importance of a rule.
 type Severity interface {
 	Severity() SeverityLevel
 }
 
+// Documented defines a rule that explains its purpose and how to fix its findings.
+type Documented interface {
+	Documentation() Documentation
+}
+
+// Documentation explains the purpose of a rule and how to fix its findings.
+type Documentation struct {
+	// Description describes what the rule checks.
+	Description string
+	// Rationale explains why the rule matters.
+	Rationale string
+	// Remediation describes how findings of the rule can be fixed.
+	Remediation string
+	// References are references to external sources that describe the rule.
+	References []Reference
+}
+
+// ReferenceType defines the kind of an external reference.
+type ReferenceType string
+
+const (
+	// ReferenceSTIGVulnID is the Vuln ID of a rule in a DISA STIG.
+	ReferenceSTIGVulnID ReferenceType = "STIG Vuln ID"
+	// ReferenceCCI is a DISA Control Correlation Identifier.
+	ReferenceCCI ReferenceType = "CCI"
+	// ReferenceNIST80053 is a NIST SP 800-53 control.
+	ReferenceNIST80053 ReferenceType = "NIST SP 800-53"
+)
+
+// Reference is a reference to an external source that describes a rule.
+type Reference struct {
+	Type ReferenceType
+	ID   string
+	URL  string
+}
+
 // Target is used to describe the things that were checked during ruleset runs.
 type Target map[string]string
 
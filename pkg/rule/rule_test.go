@@ -20,6 +20,16 @@ var _ = Describe("rule", func() {
 		Entry("Accepted should not be less than Passed", rule.Accepted, rule.Passed, false),
 	)
 
+	DescribeTable("#SeverityLevel.Less",
+		func(s1, s2 rule.SeverityLevel, expectedResult bool) {
+			Expect(s1.Less(s2)).To(Equal(expectedResult))
+		},
+		Entry("Low should be less than High", rule.SeverityLow, rule.SeverityHigh, true),
+		Entry("High should not be less than Medium", rule.SeverityHigh, rule.SeverityMedium, false),
+		Entry("Medium should not be less than Medium", rule.SeverityMedium, rule.SeverityMedium, false),
+		Entry("unknown severity should be less than Low", rule.SeverityLevel(""), rule.SeverityLow, true),
+	)
+
 	Describe("#Target", func() {
 		It("should correctly initialize", func() {
 			t := rule.NewTarget("foo", "bar", "one", "two")
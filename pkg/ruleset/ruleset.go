@@ -6,6 +6,7 @@ package ruleset
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/diki/pkg/rule"
 )
@@ -16,6 +17,34 @@ type RulesetResult struct {
 	RulesetName    string
 	RulesetVersion string
 	RuleResults    []rule.RuleResult
+	// Exceptions contains the results of the exceptions configured for the ruleset.
+	Exceptions []ExceptionResult
+}
+
+// ExceptionState is the state of a configured exception.
+type ExceptionState string
+
+const (
+	// ExceptionActive is the state of exceptions that accepted at least one check.
+	ExceptionActive ExceptionState = "Active"
+	// ExceptionExpired is the state of exceptions whose expiry date has passed.
+	ExceptionExpired ExceptionState = "Expired"
+	// ExceptionUnused is the state of exceptions that did not match any check.
+	ExceptionUnused ExceptionState = "Unused"
+)
+
+// ExceptionResult contains the result of an exception applied to the results of a ruleset run.
+type ExceptionResult struct {
+	RuleID        string
+	Targets       []rule.Target
+	Justification string
+	Owner         string
+	Ticket        string
+	ExpiresAt     time.Time
+	State         ExceptionState
+	// Matches is the number of checks that matched the exception.
+	// Checks matched by expired exceptions keep their original status.
+	Matches int
 }
 
 // Ruleset is a set of Rules.
@@ -9,7 +9,9 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 
+	"github.com/gardener/diki/pkg/preflight"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/ruleset"
 )
@@ -21,6 +23,8 @@ type Logger interface {
 }
 
 // RunAll is a sample implementation for a [provider.Provider].
+// Results of rulesets that return an error are still included in the returned
+// result when available, so that the result is always usable for reporting.
 func RunAll(ctx context.Context, p provider.Provider, rulesets map[string]ruleset.Ruleset, log Logger) (provider.ProviderResult, error) {
 	if len(rulesets) == 0 {
 		return provider.ProviderResult{}, fmt.Errorf("no rulests are registered with the provider")
@@ -39,22 +43,44 @@ func RunAll(ctx context.Context, p provider.Provider, rulesets map[string]rulese
 	for _, rs := range rulesets {
 		select {
 		case <-ctx.Done():
-			return provider.ProviderResult{}, ctx.Err()
+			return result, errors.Join(errAgg, context.Cause(ctx))
 		default:
 			log.Info("starting ruleset run", "ruleset", rs.ID(), "version", rs.Version())
-			if res, err := rs.Run(ctx); err != nil {
+			res, err := rs.Run(ctx)
+			if err != nil {
 				errAgg = errors.Join(errAgg, fmt.Errorf("ruleset with id %s and version %s errored: %w", rs.ID(), rs.Version(), err))
 				log.Error(finishMsg, "ruleset", rs.ID(), "version", rs.Version(), "error", err)
 			} else {
-				result.RulesetResults = append(result.RulesetResults, res)
 				log.Info(finishMsg, "ruleset", rs.ID(), "version", rs.Version())
 			}
+
+			if len(res.RuleResults) > 0 {
+				result.RulesetResults = append(result.RulesetResults, res)
+			}
 		}
 	}
 	log.Info("finished provider run")
 
-	if errAgg != nil {
-		return provider.ProviderResult{}, errAgg
+	return result, errAgg
+}
+
+// PreflightRequirements is a sample implementation for a [provider.PreflightProvider].
+// The requirements of the given clusters are extended with the requirements of the rulesets.
+// Ruleset requirements without a cluster name are assigned to the first given cluster.
+func PreflightRequirements(clusters []preflight.Requirement, rulesets map[string]ruleset.Ruleset) []preflight.Requirement {
+	requirements := slices.Clone(clusters)
+	for _, key := range slices.Sorted(maps.Keys(rulesets)) {
+		preflightRuleset, ok := rulesets[key].(preflight.Ruleset)
+		if !ok {
+			continue
+		}
+
+		for _, requirement := range preflightRuleset.PreflightRequirements() {
+			if len(requirement.Cluster) == 0 && len(clusters) > 0 {
+				requirement.Cluster = clusters[0].Cluster
+			}
+			requirements = append(requirements, requirement)
+		}
 	}
-	return result, nil
+	return preflight.MergeRequirements(requirements)
 }
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package custom
+
+import (
+	"log/slog"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+// CreateOption is a function that acts on a [Ruleset]
+// and is used to construct such objects.
+type CreateOption func(*Ruleset)
+
+// WithVersion sets the version of a [Ruleset].
+func WithVersion(version string) CreateOption {
+	return func(r *Ruleset) {
+		r.version = version
+	}
+}
+
+// WithConfig sets the Config of a [Ruleset].
+func WithConfig(config *rest.Config) CreateOption {
+	return func(r *Ruleset) {
+		r.Config = config
+	}
+}
+
+// WithNumberOfWorkers sets the max number of Workers of a [Ruleset].
+func WithNumberOfWorkers(numWorkers int) CreateOption {
+	return func(r *Ruleset) {
+		if numWorkers <= 0 {
+			panic("number of workers should be a possitive number")
+		}
+		r.numWorkers = numWorkers
+	}
+}
+
+// WithRuleTimeouts sets the rule timeouts of a [Ruleset].
+func WithRuleTimeouts(ruleTimeouts sharedruleset.RuleTimeouts) CreateOption {
+	return func(r *Ruleset) {
+		r.ruleTimeouts = ruleTimeouts
+	}
+}
+
+// WithExceptions sets the exceptions of a [Ruleset].
+func WithExceptions(exceptions []config.ExceptionConfig) CreateOption {
+	return func(r *Ruleset) {
+		r.exceptions = exceptions
+	}
+}
+
+// WithLogger the logger of a [Ruleset].
+func WithLogger(logger *slog.Logger) CreateOption {
+	return func(r *Ruleset) {
+		r.logger = logger
+	}
+}
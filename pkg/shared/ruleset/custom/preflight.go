@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package custom
+
+import (
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/gardener/diki/pkg/preflight"
+)
+
+var _ preflight.Ruleset = &Ruleset{}
+
+// PreflightRequirements returns the requirements of the Ruleset for the provider's cluster.
+// Resources are guessed from the kinds of the rule definitions.
+func (r *Ruleset) PreflightRequirements() []preflight.Requirement {
+	requirement := preflight.Requirement{Config: r.Config}
+	for _, celRule := range r.celRules {
+		var (
+			resource  = celRule.Definition().Resource
+			plural, _ = meta.UnsafeGuessKindToResource(resource.GroupVersionKind())
+		)
+		namespaces := resource.Namespaces
+		if len(namespaces) == 0 {
+			namespaces = []string{""}
+		}
+		for _, namespace := range namespaces {
+			requirement.Permissions = append(requirement.Permissions, authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "list",
+				Group:     plural.Group,
+				Version:   plural.Version,
+				Resource:  plural.Resource,
+			})
+		}
+
+		if len(resource.NamespaceMatchLabels) > 0 || (celRule.Options != nil && len(celRule.Options.AcceptedObjects) > 0) {
+			requirement.Permissions = append(requirement.Permissions, authorizationv1.ResourceAttributes{Verb: "list", Resource: "namespaces"})
+		}
+	}
+	return []preflight.Requirement{requirement}
+}
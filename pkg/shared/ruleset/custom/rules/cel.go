@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/internal/utils"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/kubernetes/option"
+	disaoptions "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+)
+
+var (
+	_ rule.Rule          = &CELRule{}
+	_ rule.Severity      = &CELRule{}
+	_ disaoptions.Option = &CELRuleOptions{}
+)
+
+// ObjectVariable is the name of the variable that holds the evaluated object in CEL expressions.
+const ObjectVariable = "object"
+
+// ResourceSelector selects the Kubernetes objects that are evaluated by a [CELRule].
+type ResourceSelector struct {
+	Group   string `json:"group" yaml:"group"`
+	Version string `json:"version" yaml:"version"`
+	Kind    string `json:"kind" yaml:"kind"`
+	// Namespaces limits the selected objects to the given namespaces.
+	// Objects of all namespaces are selected if it is empty.
+	Namespaces []string `json:"namespaces" yaml:"namespaces"`
+	// NamespaceMatchLabels limits the selected objects to namespaces with the given labels.
+	NamespaceMatchLabels map[string]string `json:"namespaceMatchLabels" yaml:"namespaceMatchLabels"`
+	// MatchLabels limits the selected objects to objects with the given labels.
+	MatchLabels map[string]string `json:"matchLabels" yaml:"matchLabels"`
+}
+
+// GroupVersionKind returns the group version kind of the selected objects.
+func (s ResourceSelector) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: s.Group, Version: s.Version, Kind: s.Kind}
+}
+
+// Validate validates that the resource selector is correctly defined.
+func (s ResourceSelector) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(s.Version) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("version"), "must not be empty"))
+	}
+	if len(s.Kind) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kind"), "must not be empty"))
+	}
+	for i, namespace := range s.Namespaces {
+		if len(namespace) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespaces").Index(i), namespace, "must not be empty"))
+		}
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(s.NamespaceMatchLabels, fldPath.Child("namespaceMatchLabels"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(s.MatchLabels, fldPath.Child("matchLabels"))...)
+	return allErrs
+}
+
+// CELRuleDefinition defines a rule that evaluates a CEL expression for every selected object.
+type CELRuleDefinition struct {
+	ID       string             `json:"id" yaml:"id"`
+	Name     string             `json:"name" yaml:"name"`
+	Severity rule.SeverityLevel `json:"severity" yaml:"severity"`
+	Resource ResourceSelector   `json:"resource" yaml:"resource"`
+	// Expression is a CEL expression that has to evaluate to true for compliant objects.
+	// The evaluated object is available in the variable "object".
+	Expression string `json:"expression" yaml:"expression"`
+	// Message is the message of checks for objects that do not satisfy the expression.
+	Message string `json:"message" yaml:"message"`
+}
+
+// Validate validates that the rule definition is correctly defined.
+// It does not validate the expression, which is validated when the rule is created.
+func (d CELRuleDefinition) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(d.ID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("id"), "must not be empty"))
+	}
+	if len(d.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must not be empty"))
+	}
+	if !slices.Contains(rule.Severities(), d.Severity) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("severity"), d.Severity, rule.Severities()))
+	}
+	if len(d.Expression) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("expression"), "must not be empty"))
+	}
+	if len(d.Message) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("message"), "must not be empty"))
+	}
+
+	allErrs = append(allErrs, d.Resource.Validate(fldPath.Child("resource"))...)
+	return allErrs
+}
+
+// CELRuleOptions contains the options of a [CELRule].
+type CELRuleOptions struct {
+	// AcceptedObjects contains the accepted namespaced objects that do not satisfy the expression.
+	AcceptedObjects []option.AcceptedNamespacedObject `json:"acceptedObjects" yaml:"acceptedObjects"`
+	// AcceptedClusterObjects contains the accepted cluster scoped objects that do not satisfy the expression.
+	AcceptedClusterObjects []option.AcceptedClusterObject `json:"acceptedClusterObjects" yaml:"acceptedClusterObjects"`
+}
+
+// Validate validates that option configurations are correctly defined
+func (o CELRuleOptions) Validate() field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, o := range o.AcceptedObjects {
+		allErrs = append(allErrs, o.Validate()...)
+	}
+	for _, o := range o.AcceptedClusterObjects {
+		allErrs = append(allErrs, o.Validate()...)
+	}
+
+	return allErrs
+}
+
+// CELRule evaluates a CEL expression for every object selected by its resource selector.
+type CELRule struct {
+	Client     client.Client
+	Options    *CELRuleOptions
+	definition CELRuleDefinition
+	program    cel.Program
+}
+
+// NewCELRule creates a [CELRule] from a definition and compiles its expression.
+func NewCELRule(definition CELRuleDefinition, c client.Client, options *CELRuleOptions) (*CELRule, error) {
+	program, err := CompileExpression(definition.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CELRule{
+		Client:     c,
+		Options:    options,
+		definition: definition,
+		program:    program,
+	}, nil
+}
+
+// CompileExpression compiles a CEL expression that evaluates an object to a bool.
+func CompileExpression(expression string) (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(ObjectVariable, cel.DynType),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, iss := env.Compile(expression)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("failed to compile expression: %w", iss.Err())
+	}
+
+	if outputType := ast.OutputType(); !outputType.IsExactType(types.BoolType) && !outputType.IsExactType(types.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to bool, but evaluates to %s", outputType)
+	}
+
+	return env.Program(ast, cel.InterruptCheckFrequency(100))
+}
+
+// Definition returns the definition of the rule.
+func (r *CELRule) Definition() CELRuleDefinition {
+	return r.definition
+}
+
+func (r *CELRule) ID() string {
+	return r.definition.ID
+}
+
+func (r *CELRule) Name() string {
+	return r.definition.Name
+}
+
+func (r *CELRule) Severity() rule.SeverityLevel {
+	return r.definition.Severity
+}
+
+func (r *CELRule) Run(ctx context.Context) (rule.RuleResult, error) {
+	var (
+		checkResults []rule.CheckResult
+		resource     = r.definition.Resource
+		listTarget   = rule.NewTarget("kind", resource.Kind+"List")
+	)
+
+	objects, err := r.getObjects(ctx)
+	if err != nil {
+		return rule.Result(r, rule.ErroredCheckResult(err.Error(), listTarget)), nil
+	}
+
+	var namespaces map[string]corev1.Namespace
+	if len(resource.NamespaceMatchLabels) > 0 || (r.Options != nil && len(r.Options.AcceptedObjects) > 0) {
+		if namespaces, err = kubeutils.GetNamespaces(ctx, r.Client); err != nil {
+			return rule.Result(r, rule.ErroredCheckResult(err.Error(), rule.NewTarget("kind", "NamespaceList"))), nil
+		}
+	}
+
+	for _, object := range objects {
+		namespace := namespaces[object.GetNamespace()]
+		if len(resource.NamespaceMatchLabels) > 0 && (len(object.GetNamespace()) == 0 || !utils.MatchLabels(namespace.Labels, resource.NamespaceMatchLabels)) {
+			continue
+		}
+
+		target := kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: object.GetKind()}, metav1.ObjectMeta{
+			Name:            object.GetName(),
+			Namespace:       object.GetNamespace(),
+			OwnerReferences: object.GetOwnerReferences(),
+		})
+
+		satisfied, err := r.evaluate(ctx, object)
+		switch {
+		case err != nil:
+			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), target))
+		case satisfied:
+			checkResults = append(checkResults, rule.PassedCheckResult("Object satisfies the rule expression.", target))
+		default:
+			if accepted, justification := r.accepted(object, namespace); accepted {
+				msg := cmp.Or(justification, "Object is accepted to not satisfy the rule expression.")
+				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, target))
+			} else {
+				checkResults = append(checkResults, rule.FailedCheckResult(r.definition.Message, target))
+			}
+		}
+	}
+
+	if len(checkResults) == 0 {
+		return rule.Result(r, rule.PassedCheckResult("There are no objects selected by the rule.", rule.NewTarget("kind", resource.Kind))), nil
+	}
+
+	return rule.Result(r, checkResults...), nil
+}
+
+func (r *CELRule) getObjects(ctx context.Context) ([]unstructured.Unstructured, error) {
+	var (
+		resource   = r.definition.Resource
+		selector   = labels.SelectorFromSet(resource.MatchLabels)
+		namespaces = resource.Namespaces
+	)
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+
+	var objects []unstructured.Unstructured
+	for _, namespace := range namespaces {
+		namespaceObjects, err := kubeutils.GetObjects(ctx, r.Client, resource.GroupVersionKind(), namespace, selector, 300)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, namespaceObjects...)
+	}
+	return objects, nil
+}
+
+func (r *CELRule) evaluate(ctx context.Context, object unstructured.Unstructured) (bool, error) {
+	out, _, err := r.program.ContextEval(ctx, map[string]any{ObjectVariable: object.Object})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+
+	satisfied, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.New("expression did not evaluate to bool")
+	}
+	return satisfied, nil
+}
+
+func (r *CELRule) accepted(object unstructured.Unstructured, namespace corev1.Namespace) (bool, string) {
+	if r.Options == nil {
+		return false, ""
+	}
+
+	if len(object.GetNamespace()) == 0 {
+		for _, acceptedObject := range r.Options.AcceptedClusterObjects {
+			if utils.MatchLabels(object.GetLabels(), acceptedObject.MatchLabels) {
+				return true, acceptedObject.Justification
+			}
+		}
+		return false, ""
+	}
+
+	for _, acceptedObject := range r.Options.AcceptedObjects {
+		if utils.MatchLabels(object.GetLabels(), acceptedObject.MatchLabels) &&
+			utils.MatchLabels(namespace.Labels, acceptedObject.NamespaceMatchLabels) {
+			return true, acceptedObject.Justification
+		}
+	}
+
+	return false, ""
+}
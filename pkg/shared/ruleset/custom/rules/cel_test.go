@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/kubernetes/option"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom/rules"
+)
+
+var _ = Describe("CELRule", func() {
+	var (
+		fakeClient client.Client
+		ctx        = context.TODO()
+		definition rules.CELRuleDefinition
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		definition = rules.CELRuleDefinition{
+			ID:       "custom-1",
+			Name:     "ConfigMaps must have an owner.",
+			Severity: rule.SeverityMedium,
+			Resource: rules.ResourceSelector{
+				Version: "v1",
+				Kind:    "ConfigMap",
+			},
+			Expression: "has(object.metadata.labels) && 'owner' in object.metadata.labels",
+			Message:    "ConfigMap does not have an owner.",
+		}
+
+		for _, namespace := range []*corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"team": "foo"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "bar", Labels: map[string]string{"team": "bar"}}},
+		} {
+			Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+		}
+	})
+
+	createConfigMap := func(name, namespace string, labels map[string]string) {
+		Expect(fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}})).To(Succeed())
+	}
+
+	Describe("#Run", func() {
+		It("should pass when no objects are selected", func() {
+			r, err := rules.NewCELRule(definition, fakeClient, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.RuleID).To(Equal("custom-1"))
+			Expect(ruleResult.Severity).To(Equal(rule.SeverityMedium))
+			Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+				rule.PassedCheckResult("There are no objects selected by the rule.", rule.NewTarget("kind", "ConfigMap")),
+			}))
+		})
+
+		It("should evaluate the expression for every object", func() {
+			createConfigMap("foo", "foo", map[string]string{"owner": "foo"})
+			createConfigMap("bar", "foo", nil)
+
+			r, err := rules.NewCELRule(definition, fakeClient, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(ConsistOf(
+				rule.PassedCheckResult("Object satisfies the rule expression.", rule.NewTarget("kind", "ConfigMap", "name", "foo", "namespace", "foo")),
+				rule.FailedCheckResult("ConfigMap does not have an owner.", rule.NewTarget("kind", "ConfigMap", "name", "bar", "namespace", "foo")),
+			))
+		})
+
+		It("should only evaluate objects matching the resource selector", func() {
+			createConfigMap("foo", "foo", map[string]string{"app": "foo"})
+			createConfigMap("bar", "foo", map[string]string{"app": "bar"})
+			createConfigMap("baz", "bar", map[string]string{"app": "foo"})
+			createConfigMap("qux", "baz", map[string]string{"app": "foo"})
+
+			definition.Resource.Namespaces = []string{"foo", "bar"}
+			definition.Resource.NamespaceMatchLabels = map[string]string{"team": "foo"}
+			definition.Resource.MatchLabels = map[string]string{"app": "foo"}
+			r, err := rules.NewCELRule(definition, fakeClient, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+				rule.FailedCheckResult("ConfigMap does not have an owner.", rule.NewTarget("kind", "ConfigMap", "name", "foo", "namespace", "foo")),
+			}))
+		})
+
+		It("should accept objects with justification", func() {
+			createConfigMap("foo", "foo", map[string]string{"app": "foo"})
+			createConfigMap("bar", "bar", map[string]string{"app": "foo"})
+
+			r, err := rules.NewCELRule(definition, fakeClient, &rules.CELRuleOptions{
+				AcceptedObjects: []option.AcceptedNamespacedObject{
+					{
+						NamespacedObjectSelector: option.NamespacedObjectSelector{
+							MatchLabels:          map[string]string{"app": "foo"},
+							NamespaceMatchLabels: map[string]string{"team": "foo"},
+						},
+						Justification: "foo is special",
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(ConsistOf(
+				rule.AcceptedCheckResult("foo is special", rule.NewTarget("kind", "ConfigMap", "name", "foo", "namespace", "foo")),
+				rule.FailedCheckResult("ConfigMap does not have an owner.", rule.NewTarget("kind", "ConfigMap", "name", "bar", "namespace", "bar")),
+			))
+		})
+
+		It("should accept cluster scoped objects", func() {
+			definition.Resource.Kind = "Namespace"
+			definition.Expression = "object.metadata.name.startsWith('kube-')"
+			definition.Message = "Namespace is not a system namespace."
+			r, err := rules.NewCELRule(definition, fakeClient, &rules.CELRuleOptions{
+				AcceptedClusterObjects: []option.AcceptedClusterObject{
+					{ClusterObjectSelector: option.ClusterObjectSelector{MatchLabels: map[string]string{"team": "foo"}}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(ConsistOf(
+				rule.AcceptedCheckResult("Object is accepted to not satisfy the rule expression.", rule.NewTarget("kind", "Namespace", "name", "foo")),
+				rule.FailedCheckResult("Namespace is not a system namespace.", rule.NewTarget("kind", "Namespace", "name", "bar")),
+			))
+		})
+
+		It("should error when the expression can not be evaluated", func() {
+			createConfigMap("foo", "foo", nil)
+
+			definition.Expression = "object.data.foo == 'bar'"
+			r, err := rules.NewCELRule(definition, fakeClient, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(HaveLen(1))
+			Expect(ruleResult.CheckResults[0].Status).To(Equal(rule.Errored))
+			Expect(ruleResult.CheckResults[0].Message).To(HavePrefix("failed to evaluate expression: "))
+			Expect(ruleResult.CheckResults[0].Target).To(Equal(rule.NewTarget("kind", "ConfigMap", "name", "foo", "namespace", "foo")))
+		})
+	})
+
+	Describe("#NewCELRule", func() {
+		DescribeTable("should return an error for invalid expressions",
+			func(expression, expectedErr string) {
+				definition.Expression = expression
+				_, err := rules.NewCELRule(definition, fakeClient, nil)
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("syntax error", "object.metadata.name ==", "failed to compile expression"),
+			Entry("undeclared variable", "foo == 'bar'", "undeclared reference to 'foo'"),
+			Entry("non bool result", "'foo'", "expression must evaluate to bool, but evaluates to string"),
+		)
+	})
+
+	Describe("#Validate", func() {
+		It("should validate the rule definition", func() {
+			Expect(definition.Validate(field.NewPath("rules").Index(0))).To(BeEmpty())
+
+			definition = rules.CELRuleDefinition{Severity: "foo", Resource: rules.ResourceSelector{Namespaces: []string{""}}}
+			errList := definition.Validate(field.NewPath("rules").Index(0))
+			Expect(errList.ToAggregate().Error()).To(And(
+				ContainSubstring("rules[0].id: Required value: must not be empty"),
+				ContainSubstring("rules[0].name: Required value: must not be empty"),
+				ContainSubstring(`rules[0].severity: Unsupported value: "foo"`),
+				ContainSubstring("rules[0].expression: Required value: must not be empty"),
+				ContainSubstring("rules[0].message: Required value: must not be empty"),
+				ContainSubstring("rules[0].resource.version: Required value: must not be empty"),
+				ContainSubstring("rules[0].resource.kind: Required value: must not be empty"),
+				ContainSubstring(`rules[0].resource.namespaces[0]: Invalid value: "": must not be empty`),
+			))
+		})
+	})
+})
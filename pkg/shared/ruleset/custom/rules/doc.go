@@ -0,0 +1,6 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rules implements rules of the custom ruleset that are defined in the Diki configuration.
+package rules
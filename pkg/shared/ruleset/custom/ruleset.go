@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom/rules"
+)
+
+const (
+	// RulesetID is a constant containing the id of the Custom Ruleset.
+	RulesetID = "custom"
+	// RulesetName is a constant containing the user-friendly name of the Custom Ruleset.
+	RulesetName = "Custom"
+)
+
+var (
+	_ ruleset.Ruleset = &Ruleset{}
+	// SupportedVersions is a list of available versions for the Custom Ruleset.
+	// Versions are sorted from newest to oldest.
+	SupportedVersions = []string{"v0.1.0"}
+)
+
+// Ruleset implements a ruleset with rules that are defined in the Diki configuration.
+type Ruleset struct {
+	version      string
+	rules        map[string]rule.Rule
+	celRules     []*rules.CELRule
+	Config       *rest.Config
+	numWorkers   int
+	ruleTimeouts sharedruleset.RuleTimeouts
+	exceptions   []config.ExceptionConfig
+	logger       *slog.Logger
+}
+
+// Args are Ruleset specific arguments.
+type Args struct {
+	// RuleTimeout is the max duration of a single rule run. It can be overwritten per rule.
+	RuleTimeout *metav1.Duration `json:"ruleTimeout" yaml:"ruleTimeout"`
+	// Rules contains the definitions of the rules of the ruleset.
+	Rules []rules.CELRuleDefinition `json:"rules" yaml:"rules"`
+}
+
+// Validate validates that the ruleset arguments are correctly defined.
+func (a Args) Validate() field.ErrorList {
+	var (
+		allErrs   field.ErrorList
+		rulesPath = field.NewPath("rules")
+		ruleIDs   = map[string]struct{}{}
+	)
+
+	if len(a.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(rulesPath, "must not be empty"))
+	}
+
+	for i, definition := range a.Rules {
+		allErrs = append(allErrs, definition.Validate(rulesPath.Index(i))...)
+		if _, ok := ruleIDs[definition.ID]; ok {
+			allErrs = append(allErrs, field.Duplicate(rulesPath.Index(i).Child("id"), definition.ID))
+		}
+		ruleIDs[definition.ID] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// New creates a new Ruleset.
+func New(options ...CreateOption) (*Ruleset, error) {
+	r := &Ruleset{
+		rules:      map[string]rule.Rule{},
+		numWorkers: 5,
+	}
+
+	for _, o := range options {
+		o(r)
+	}
+
+	return r, nil
+}
+
+// ID returns the id of the Ruleset.
+func (r *Ruleset) ID() string {
+	return RulesetID
+}
+
+// Name returns the name of the Ruleset.
+func (r *Ruleset) Name() string {
+	return RulesetName
+}
+
+// Version returns the version of the Ruleset.
+func (r *Ruleset) Version() string {
+	return r.version
+}
+
+// FromGenericConfig creates a Ruleset from a RulesetConfig
+func FromGenericConfig(rulesetConfig config.RulesetConfig, restConfig *rest.Config) (*Ruleset, error) {
+	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var rulesetArgs Args
+	if err := json.Unmarshal(rulesetArgsByte, &rulesetArgs); err != nil {
+		return nil, err
+	}
+
+	if err := rulesetArgs.Validate().ToAggregate(); err != nil {
+		return nil, fmt.Errorf("ruleset %s args error: %w", rulesetConfig.ID, err)
+	}
+
+	ruleset, err := New(
+		WithVersion(rulesetConfig.Version),
+		WithConfig(restConfig),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleOptions := map[string]config.RuleOptionsConfig{}
+	for _, opt := range rulesetConfig.RuleOptions {
+		if _, ok := ruleOptions[opt.RuleID]; ok {
+			return nil, fmt.Errorf("rule option for rule id: %s is already registered", opt.RuleID)
+		}
+
+		ruleOptions[opt.RuleID] = opt
+	}
+
+	ruleTimeouts, err := sharedruleset.NewRuleTimeouts(rulesetArgs.RuleTimeout, ruleOptions)
+	if err != nil {
+		return nil, err
+	}
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
+	if err := sharedruleset.ValidateExceptions(rulesetConfig.Exceptions); err != nil {
+		return nil, fmt.Errorf("invalid exceptions for ruleset with id %s and version %s: %w", rulesetConfig.ID, rulesetConfig.Version, err)
+	}
+	setExceptions := WithExceptions(rulesetConfig.Exceptions)
+	setExceptions(ruleset)
+
+	switch rulesetConfig.Version {
+	case "v0.1.0":
+		if err := ruleset.registerV01Rules(rulesetArgs.Rules, ruleOptions); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown ruleset %s version: %s", rulesetConfig.ID, rulesetConfig.Version)
+	}
+
+	pluginRules, err := sharedruleset.PluginRules(rulesetConfig.Plugins, restConfig, ruleOptions, ruleset.Logger())
+	if err != nil {
+		return nil, err
+	}
+	if err := ruleset.AddRules(pluginRules...); err != nil {
+		return nil, err
+	}
+	return ruleset, nil
+}
+
+// RunRule executes specific known Rule of the Ruleset.
+func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, error) {
+	rr, ok := r.rules[id]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("rule with id %s is not registered in the ruleset", id)
+	}
+
+	res, err := sharedruleset.RunRule(ctx, rr, r.ruleTimeouts.Timeout(id), r.Logger())
+	if err != nil {
+		res = sharedruleset.ErroredRuleResult(rr, err)
+	}
+	sharedruleset.ApplyRuleExceptions(&res, r.exceptions, time.Now())
+	return res, err
+}
+
+// Run executes all known Rules of the Ruleset.
+func (r *Ruleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
+	res, err := sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.ruleTimeouts, r.Logger())
+	sharedruleset.ApplyExceptions(&res, r.exceptions, time.Now())
+	return res, err
+}
+
+// AddRules adds Rules to the Ruleset.
+func (r *Ruleset) AddRules(rules ...rule.Rule) error {
+	for _, rr := range rules {
+		if _, ok := r.rules[rr.ID()]; ok {
+			return fmt.Errorf("rule with id %s already exists", rr.ID())
+		}
+		r.rules[rr.ID()] = rr
+	}
+	return nil
+}
+
+// Logger returns the Ruleset's logger.
+// If not set it set it to slog.Default().With("ruleset", r.ID(), "version", r.Version() then return it.
+func (r *Ruleset) Logger() *slog.Logger {
+	if r.logger == nil {
+		r.logger = slog.Default().With("ruleset", r.ID(), "version", r.Version())
+	}
+	return r.logger
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package custom_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/preflight"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom"
+)
+
+var _ = Describe("custom", func() {
+	var (
+		restConfig    = &rest.Config{Host: "https://localhost:6443"}
+		rulesetConfig config.RulesetConfig
+	)
+
+	BeforeEach(func() {
+		rulesetConfig = config.RulesetConfig{
+			ID:      custom.RulesetID,
+			Version: "v0.1.0",
+			Args: map[string]any{
+				"rules": []any{
+					map[string]any{
+						"id":         "custom-1",
+						"name":       "foo",
+						"severity":   "High",
+						"resource":   map[string]any{"version": "v1", "kind": "ConfigMap"},
+						"expression": "has(object.data)",
+						"message":    "ConfigMap has no data.",
+					},
+					map[string]any{
+						"id":         "custom-2",
+						"name":       "bar",
+						"severity":   "Low",
+						"resource":   map[string]any{"group": "apps", "version": "v1", "kind": "Deployment"},
+						"expression": "object.spec.replicas > 1",
+						"message":    "Deployment is not highly available.",
+					},
+				},
+			},
+			RuleOptions: []config.RuleOptionsConfig{
+				{RuleID: "custom-2", Skip: &config.RuleOptionSkipConfig{Enabled: true, Justification: "not needed"}},
+			},
+		}
+	})
+
+	Describe("#FromGenericConfig", func() {
+		It("should register the configured rules", func() {
+			r, err := custom.FromGenericConfig(rulesetConfig, restConfig)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.ID()).To(Equal(custom.RulesetID))
+			Expect(r.Version()).To(Equal("v0.1.0"))
+
+			ruleResult, err := r.RunRule(context.TODO(), "custom-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{{Status: rule.Accepted, Message: "not needed"}}))
+			Expect(ruleResult.Severity).To(Equal(rule.SeverityLow))
+		})
+
+		It("should return an error for duplicate rule ids", func() {
+			rules := rulesetConfig.Args.(map[string]any)["rules"].([]any)
+			rules[1].(map[string]any)["id"] = "custom-1"
+
+			_, err := custom.FromGenericConfig(rulesetConfig, restConfig)
+			Expect(err).To(MatchError(`ruleset custom args error: rules[1].id: Duplicate value: "custom-1"`))
+		})
+
+		It("should return an error when no rules are defined", func() {
+			rulesetConfig.Args = nil
+
+			_, err := custom.FromGenericConfig(rulesetConfig, restConfig)
+			Expect(err).To(MatchError("ruleset custom args error: rules: Required value: must not be empty"))
+		})
+
+		It("should return an error for invalid expressions", func() {
+			rules := rulesetConfig.Args.(map[string]any)["rules"].([]any)
+			rules[0].(map[string]any)["expression"] = "'foo'"
+
+			_, err := custom.FromGenericConfig(rulesetConfig, restConfig)
+			Expect(err).To(MatchError(ContainSubstring("rule custom-1 error: ")))
+		})
+
+		It("should return the preflight requirements of rules that are not skipped", func() {
+			rules := rulesetConfig.Args.(map[string]any)["rules"].([]any)
+			rules[0].(map[string]any)["resource"] = map[string]any{"version": "v1", "kind": "ConfigMap", "namespaces": []any{"foo", "bar"}, "namespaceMatchLabels": map[string]any{"foo": "bar"}}
+
+			r, err := custom.FromGenericConfig(rulesetConfig, restConfig)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.PreflightRequirements()).To(Equal([]preflight.Requirement{{
+				Config: restConfig,
+				Permissions: []authorizationv1.ResourceAttributes{
+					{Namespace: "foo", Verb: "list", Version: "v1", Resource: "configmaps"},
+					{Namespace: "bar", Verb: "list", Version: "v1", Resource: "configmaps"},
+					{Verb: "list", Resource: "namespaces"},
+				},
+			}}))
+		})
+
+		It("should register plugin rules", func() {
+			rulesetConfig.Plugins = []config.PluginConfig{
+				{ID: "plugin-1", Name: "baz", Severity: "Medium", Command: "/foo"},
+			}
+			rulesetConfig.RuleOptions = append(rulesetConfig.RuleOptions, config.RuleOptionsConfig{
+				RuleID: "plugin-1", Skip: &config.RuleOptionSkipConfig{Enabled: true, Justification: "not needed"},
+			})
+
+			r, err := custom.FromGenericConfig(rulesetConfig, restConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			ruleResult, err := r.RunRule(context.TODO(), "plugin-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{{Status: rule.Accepted, Message: "not needed"}}))
+			Expect(ruleResult.Severity).To(Equal(rule.SeverityMedium))
+		})
+
+		It("should return an error for plugin rules with the id of a configured rule", func() {
+			rulesetConfig.Plugins = []config.PluginConfig{
+				{ID: "custom-1", Name: "baz", Severity: "Medium", Command: "/foo"},
+			}
+
+			_, err := custom.FromGenericConfig(rulesetConfig, restConfig)
+			Expect(err).To(MatchError("rule with id custom-1 already exists"))
+		})
+
+		It("should return an error for unknown versions", func() {
+			rulesetConfig.Version = "v0.0.1"
+
+			_, err := custom.FromGenericConfig(rulesetConfig, restConfig)
+			Expect(err).To(MatchError("unknown ruleset custom version: v0.0.1"))
+		})
+	})
+})
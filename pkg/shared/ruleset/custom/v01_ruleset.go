@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package custom
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/retry"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom/rules"
+)
+
+func (r *Ruleset) registerV01Rules(definitions []rules.CELRuleDefinition, ruleOptions map[string]config.RuleOptionsConfig) error {
+	c, err := client.New(r.Config, client.Options{})
+	if err != nil {
+		return err
+	}
+
+	var (
+		registeredRules = make([]rule.Rule, 0, len(definitions))
+		logger          = r.Logger()
+	)
+	for _, definition := range definitions {
+		opts, err := getV01OptionOrNil(ruleOptions[definition.ID].Args)
+		if err != nil {
+			return fmt.Errorf("rule option %s error: %s", definition.ID, err.Error())
+		}
+
+		celRule, err := rules.NewCELRule(definition, c, opts)
+		if err != nil {
+			return fmt.Errorf("rule %s error: %w", definition.ID, err)
+		}
+
+		var registeredRule rule.Rule = celRule
+		opt, found := ruleOptions[definition.ID]
+		switch {
+		case found && opt.Skip != nil && opt.Skip.Enabled:
+			registeredRule = rule.NewSkipRule(definition.ID, definition.Name, opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(definition.Severity))
+		case found && len(opt.RetryPatterns) > 0:
+			registeredRule, err = sharedruleset.WithRetryPatterns(celRule, opt.RetryPatterns, retry.WithLogger(logger.With("rule_id", definition.ID)))
+			if err != nil {
+				return err
+			}
+		}
+
+		// skipped rules do not access the cluster
+		if _, skipped := registeredRule.(*rule.SkipRule); !skipped {
+			r.celRules = append(r.celRules, celRule)
+		}
+		registeredRules = append(registeredRules, registeredRule)
+	}
+
+	return r.AddRules(registeredRules...)
+}
+
+func getV01OptionOrNil(options any) (*rules.CELRuleOptions, error) {
+	if options == nil {
+		return nil, nil
+	}
+
+	optionsByte, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedOptions rules.CELRuleOptions
+	if err := json.Unmarshal(optionsByte, &parsedOptions); err != nil {
+		return nil, err
+	}
+
+	if err := parsedOptions.Validate().ToAggregate(); err != nil {
+		return nil, err
+	}
+
+	return &parsedOptions, nil
+}
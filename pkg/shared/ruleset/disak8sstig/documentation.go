@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disak8sstig
+
+import (
+	_ "embed"
+
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+//go:embed documentation.yaml
+var documentationYAML []byte
+
+// Documentation contains the documentation of the DISA Kubernetes STIG rules by rule ID.
+var Documentation = sharedruleset.MustParseRuleDocumentation(documentationYAML)
@@ -6,17 +6,28 @@ package retryerrors
 
 import (
 	"regexp"
+	"strings"
+
+	"github.com/gardener/diki/pkg/kubernetes/containerruntime"
 )
 
 var (
-	// ContainerNotFoundOnNodeRegexp regex to match container on node not found
-	ContainerNotFoundOnNodeRegexp = regexp.MustCompile(`(?i)(command /bin/sh /run/containerd.*not found)`)
+	// ContainerNotFoundOnNodeRegexp regex to match container on node not found in the state of any supported container runtime
+	ContainerNotFoundOnNodeRegexp = containerNotFoundOnNodeRegexp(containerruntime.StateRoots())
 	// ContainerFileNotFoundOnNodeRegexp regex to match container file path on node not found
 	ContainerFileNotFoundOnNodeRegexp = regexp.MustCompile(`(?i)(command /bin/sh (find|stat).*No such file or directory)`)
 	// ContainerNotReadyRegexp regex to match container not yet in status or not running
 	ContainerNotReadyRegexp = regexp.MustCompile(`(?i)(container with name .* (not \(yet\) in status|not \(yet\) running))`)
-	// OpsPodNotFoundRegexp regex to match ops pod not found for DISA K8s STIG ruleset
-	OpsPodNotFoundRegexp = regexp.MustCompile(`(?i)(pods "diki-[\d]{6}-.{10}" not found)`)
-	// ObjectNotFoundRegexp regex to match object not found by nerdctl
-	ObjectNotFoundRegexp = regexp.MustCompile(`(?i)(command /bin/sh /.*/nerdctl .* \[no such object)`)
+	// OpsPodNotFoundRegexp regex to match ops pod not found for DISA K8s STIG ruleset, including pooled ops pods
+	OpsPodNotFoundRegexp = regexp.MustCompile(`(?i)(pods "diki-([\d]{6}|pool)-.{10}" not found)`)
+	// ContainerNotFoundByRuntimeRegexp regex to match container not found by the container runtime inspector
+	ContainerNotFoundByRuntimeRegexp = regexp.MustCompile(`(?is)(command /bin/sh .* stderr output: container \S+ not found)`)
 )
+
+func containerNotFoundOnNodeRegexp(stateRoots []string) *regexp.Regexp {
+	quotedStateRoots := make([]string, 0, len(stateRoots))
+	for _, stateRoot := range stateRoots {
+		quotedStateRoots = append(quotedStateRoots, regexp.QuoteMeta(stateRoot))
+	}
+	return regexp.MustCompile(`(?is)(command /bin/sh \S*(` + strings.Join(quotedStateRoots, "|") + `)/.*not found)`)
+}
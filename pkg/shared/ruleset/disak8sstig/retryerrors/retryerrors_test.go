@@ -17,6 +17,9 @@ var _ = Describe("retryerrors", func() {
 			Expect(retryerrors.ContainerNotFoundOnNodeRegexp.MatchString(s)).To(Equal(expectedResult))
 		},
 		Entry("Should match container not found", "command /bin/sh /run/containerd/io.containerd.runtime.v2.task/k8s.io/id foo not found", true),
+		Entry("Should match CRI-O container not found", "command /bin/sh /run/containers/storage/overlay-containers/id/userdata foo not found", true),
+		Entry("Should match container not found by the runtime inspector", "command /bin/sh config=/run/containers/storage/overlay-containers/id/userdata/config.json\nif [ -f \"$config\" ]; then\nfi stderr output: container id not found", true),
+		Entry("Should not match when it is not in the state of a runtime", "command /bin/sh cat /var/foo not found", false),
 		Entry("Should not match when it is found", "command /bin/sh /run/containerd/io.containerd.runtime.v2.task/k8s.io/id foo found", false),
 		Entry("Should not match when it is not container path", "command /bin/sh find /var/foo -type f not found", false),
 	)
@@ -46,16 +49,16 @@ var _ = Describe("retryerrors", func() {
 			Expect(retryerrors.OpsPodNotFoundRegexp.MatchString(s)).To(Equal(expectedResult))
 		},
 		Entry("Should match diki pod not found", `pods "diki-111111-asdasdasda" not found`, true),
+		Entry("Should match pooled diki pod not found", `pods "diki-pool-asdasdasda" not found`, true),
 		Entry("Should not match when pod is not diki", `pods "foo" not found`, false),
 		Entry("Should not match when Pod does not fit diki pod regex", `pods "diki-1111-asdasdasda" not found`, false),
 	)
 
-	DescribeTable("#ObjectNotFoundRegexp",
+	DescribeTable("#ContainerNotFoundByRuntimeRegexp",
 		func(s string, expectedResult bool) {
-			Expect(retryerrors.ObjectNotFoundRegexp.MatchString(s)).To(Equal(expectedResult))
+			Expect(retryerrors.ContainerNotFoundByRuntimeRegexp.MatchString(s)).To(Equal(expectedResult))
 		},
-		Entry("Should match nerdctl object not found", `command /bin/sh /run/containerd/usr/local/bin/nerdctl 1 | jq -r .[0].Spec.mounts stderr output: msg="1 errors: [no such object 1]"`, true),
-		Entry("Should not match when command is not nerdctl", `command /bin/sh /run/containerd/usr/local/bin/systemctl 1 stderr output: msg="1 errors: [no such object 1]`, false),
-		Entry("Should not match when error is not not matched", `command /bin/sh /run/containerd/usr/local/bin/nerdctl 1 | jq -r .[0].Spec.mounts stderr output: msg="1 errors: [error object 1]`, false),
+		Entry("Should match container not found", "command /bin/sh config=/run/containerd/io.containerd.runtime.v2.task/k8s.io/1/config.json\n echo \"container 1 not found\" >&2 stderr output: container 1 not found", true),
+		Entry("Should not match when only the command contains the message", "command /bin/sh echo \"container 1 not found\" >&2 stderr output: jq: error", false),
 	)
 })
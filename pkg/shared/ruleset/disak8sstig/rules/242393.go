@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"slices"
 	"strings"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,11 +33,12 @@ var (
 )
 
 type Rule242393 struct {
-	InstanceID string
-	Client     client.Client
-	PodContext pod.PodContext
-	Options    *Options242393
-	Logger     provider.Logger
+	InstanceID      string
+	Client          client.Client
+	PodContext      pod.PodContext
+	OpsPodNamespace string
+	Options         *Options242393
+	Logger          provider.Logger
 }
 
 type Options242393 struct {
@@ -103,23 +103,16 @@ func (r *Rule242393) Run(ctx context.Context) (rule.RuleResult, error) {
 	for _, node := range selectedNodes {
 		podName := fmt.Sprintf("diki-%s-%s", r.ID(), Generator.Generate(10))
 		nodeTarget := kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: "Node"}, node.ObjectMeta)
-		execPodTarget := rule.NewTarget("name", podName, "namespace", "kube-system", "kind", "Pod")
-		defer func() {
-			timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-			defer cancel()
-
-			if err := r.PodContext.Delete(timeoutCtx, podName, "kube-system"); err != nil {
-				r.Logger.Error(err.Error())
-			}
-		}()
 		additionalLabels := map[string]string{
 			pod.LabelInstanceID: r.InstanceID,
 		}
-		podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, "kube-system", image.String(), node.Name, additionalLabels))
+		podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, r.OpsPodNamespace, image.String(), node.Name, additionalLabels))
 		if err != nil {
-			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
+			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), nodeTarget))
 			continue
 		}
+		execPod := podExecutor.Pod()
+		execPodTarget := rule.NewTarget("name", execPod.Name, "namespace", execPod.Namespace, "kind", "Pod")
 
 		commandResult, err := podExecutor.Execute(ctx, "/bin/sh", `ss -tulpn | grep "LISTEN" | grep -E ":22(\s|$)" || true`)
 		if err != nil {
@@ -77,11 +77,12 @@ var _ = Describe("#242393", func() {
 		func(options rules.Options242393, executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
 			podContext = fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 			r := &rules.Rule242393{
-				Logger:     testLogger,
-				InstanceID: instanceID,
-				Client:     fakeClient,
-				PodContext: podContext,
-				Options:    &options,
+				Logger:          testLogger,
+				InstanceID:      instanceID,
+				Client:          fakeClient,
+				PodContext:      podContext,
+				OpsPodNamespace: "kube-system",
+				Options:         &options,
 			}
 
 			ruleResult, err := r.Run(ctx)
@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 	"slices"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,11 +31,12 @@ var (
 )
 
 type Rule242404 struct {
-	InstanceID string
-	Client     client.Client
-	PodContext pod.PodContext
-	Options    *Options242404
-	Logger     provider.Logger
+	InstanceID      string
+	Client          client.Client
+	PodContext      pod.PodContext
+	OpsPodNamespace string
+	Options         *Options242404
+	Logger          provider.Logger
 }
 
 type Options242404 struct {
@@ -105,27 +105,19 @@ func (r *Rule242404) Run(ctx context.Context) (rule.RuleResult, error) {
 
 func (r *Rule242404) checkNode(ctx context.Context, node corev1.Node, privPodImage string) rule.CheckResult {
 	var (
-		target    = kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: "Node"}, node.ObjectMeta)
-		podName   = fmt.Sprintf("diki-%s-%s", r.ID(), Generator.Generate(10))
-		podTarget = rule.NewTarget("kind", "Pod", "namespace", "kube-system", "name", podName)
+		target  = kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: "Node"}, node.ObjectMeta)
+		podName = fmt.Sprintf("diki-%s-%s", r.ID(), Generator.Generate(10))
 	)
 
-	defer func() {
-		timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-		defer cancel()
-
-		if err := r.PodContext.Delete(timeoutCtx, podName, "kube-system"); err != nil {
-			r.Logger.Error(err.Error())
-		}
-	}()
-
 	additionalLabels := map[string]string{
 		pod.LabelInstanceID: r.InstanceID,
 	}
-	podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, "kube-system", privPodImage, node.Name, additionalLabels))
+	podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, r.OpsPodNamespace, privPodImage, node.Name, additionalLabels))
 	if err != nil {
-		return rule.ErroredCheckResult(err.Error(), podTarget)
+		return rule.ErroredCheckResult(err.Error(), target)
 	}
+	execPod := podExecutor.Pod()
+	podTarget := rule.NewTarget("kind", "Pod", "namespace", execPod.Namespace, "name", execPod.Name)
 
 	rawKubeletCommand, err := kubeutils.GetKubeletCommand(ctx, podExecutor)
 	if err != nil {
@@ -82,11 +82,12 @@ var _ = Describe("#242404", func() {
 
 			podContext = fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 			r := &rules.Rule242404{
-				Logger:     testLogger,
-				InstanceID: instanceID,
-				Client:     fakeClient,
-				PodContext: podContext,
-				Options:    &options,
+				Logger:          testLogger,
+				InstanceID:      instanceID,
+				Client:          fakeClient,
+				PodContext:      podContext,
+				OpsPodNamespace: "kube-system",
+				Options:         &options,
 			}
 
 			ruleResult, err := r.Run(ctx)
@@ -118,5 +119,31 @@ var _ = Describe("#242404", func() {
 				rule.ErroredCheckResult("foo", rule.NewTarget("name", "diki-242404-aaaaaaaaaa", "namespace", "kube-system", "kind", "Pod")),
 				rule.FailedCheckResult("Flag hostname-override set.", rule.NewTarget("kind", "Node", "name", "node2")),
 			}),
+		Entry("should return correct checkResults when pods cannot be created", nil,
+			[][]string{{kubeletPID, "--hostname-override=/foo/bar --config=./config"}},
+			[][]error{{nil, nil}},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("Flag hostname-override set.", rule.NewTarget("kind", "Node", "name", "node1")),
+				rule.ErroredCheckResult("not enough return strings have been faked", rule.NewTarget("kind", "Node", "name", "node2")),
+			}),
 	)
+
+	It("should create pods in the ops pod namespace", func() {
+		Expect(fakeClient.Create(ctx, node1)).To(Succeed())
+
+		r := &rules.Rule242404{
+			Logger:          testLogger,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			PodContext:      fakepod.NewFakeSimplePodContext([][]string{{""}}, [][]error{{errors.New("foo")}}),
+			OpsPodNamespace: "diki",
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).To(BeNil())
+
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.ErroredCheckResult("foo", rule.NewTarget("name", "diki-242404-aaaaaaaaaa", "namespace", "diki", "kind", "Pod")),
+		}))
+	})
 })
@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 	"slices"
-	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -32,11 +31,12 @@ var (
 )
 
 type Rule242407 struct {
-	InstanceID string
-	Client     client.Client
-	PodContext pod.PodContext
-	Options    *Options242407
-	Logger     provider.Logger
+	InstanceID      string
+	Client          client.Client
+	PodContext      pod.PodContext
+	OpsPodNamespace string
+	Options         *Options242407
+	Logger          provider.Logger
 }
 
 type Options242407 struct {
@@ -98,23 +98,16 @@ func (r *Rule242407) Run(ctx context.Context) (rule.RuleResult, error) {
 
 	for _, node := range selectedNodes {
 		podName := fmt.Sprintf("diki-%s-%s", r.ID(), Generator.Generate(10))
-		execPodTarget := rule.NewTarget("name", podName, "namespace", "kube-system", "kind", "Pod")
-		defer func() {
-			timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-			defer cancel()
-
-			if err := r.PodContext.Delete(timeoutCtx, podName, "kube-system"); err != nil {
-				r.Logger.Error(err.Error())
-			}
-		}()
 		additionalLabels := map[string]string{
 			pod.LabelInstanceID: r.InstanceID,
 		}
-		podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, "kube-system", image.String(), node.Name, additionalLabels))
+		podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, r.OpsPodNamespace, image.String(), node.Name, additionalLabels))
 		if err != nil {
-			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
+			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: "Node"}, node.ObjectMeta)))
 			continue
 		}
+		execPod := podExecutor.Pod()
+		execPodTarget := rule.NewTarget("name", execPod.Name, "namespace", execPod.Namespace, "kind", "Pod")
 
 		if kubeletServicePath, err = podExecutor.Execute(ctx, "/bin/sh", "systemctl show -P FragmentPath kubelet.service"); err != nil {
 			checkResults = append(checkResults, rule.ErroredCheckResult(fmt.Sprintf("could not find kubelet.service path: %s", err.Error()), execPodTarget))
@@ -7,11 +7,8 @@ package rules
 import (
 	"context"
 	"fmt"
-	"strings"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/component-base/version"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +33,7 @@ type Rule242445 struct {
 	Client             client.Client
 	Namespace          string
 	PodContext         pod.PodContext
+	OpsPodNamespace    string
 	Options            *option.FileOwnerOptions
 	Logger             provider.Logger
 	ETCDMainSelector   labels.Selector
@@ -133,43 +131,18 @@ func (r *Rule242445) Run(ctx context.Context) (rule.RuleResult, error) {
 
 	for nodeName, pods := range groupedPods {
 		podName := fmt.Sprintf("diki-%s-%s", r.ID(), Generator.Generate(10))
-		execPodTarget := target.With("name", podName, "namespace", "kube-system", "kind", "Pod")
-
-		defer func() {
-			timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-			defer cancel()
-
-			if err := r.PodContext.Delete(timeoutCtx, podName, "kube-system"); err != nil {
-				r.Logger.Error(err.Error())
-			}
-		}()
-
 		additionalLabels := map[string]string{pod.LabelInstanceID: r.InstanceID}
-		podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, "kube-system", image.String(), nodeName, additionalLabels))
+		podExecutor, err := r.PodContext.Create(ctx, pod.NewPrivilegedPod(podName, r.OpsPodNamespace, image.String(), nodeName, additionalLabels))
 		if err != nil {
-			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
-			continue
-		}
-
-		execPod := &corev1.Pod{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      podName,
-				Namespace: "kube-system",
-			},
-		}
-
-		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(execPod), execPod); err != nil {
-			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
+			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), target.With("name", nodeName, "kind", "Node")))
 			continue
 		}
-
-		execContainerID := execPod.Status.ContainerStatuses[0].ContainerID
-		execBaseContainerID := strings.Split(execContainerID, "//")[1]
-		execContainerPath := fmt.Sprintf("/run/containerd/io.containerd.runtime.v2.task/k8s.io/%s/rootfs", execBaseContainerID)
+		execPod := podExecutor.Pod()
+		execPodTarget := target.With("name", execPod.Name, "namespace", execPod.Namespace, "kind", "Pod")
 
 		for _, pod := range pods {
 			excludedSources := []string{"/lib/modules", "/usr/share/ca-certificates", "/var/log/journal"}
-			mappedFileStats, err := intutils.GetMountedFilesStats(ctx, execContainerPath, podExecutor, pod, excludedSources)
+			mappedFileStats, err := intutils.GetMountedFilesStats(ctx, podExecutor, pod, excludedSources)
 			if err != nil {
 				checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
 			}
@@ -163,6 +163,7 @@ var _ = Describe("#242445", func() {
 			Client:             fakeClient,
 			Namespace:          Namespace,
 			PodContext:         fakePodContext,
+			OpsPodNamespace:    "kube-system",
 			ETCDMainSelector:   mainSelector,
 			ETCDEventsSelector: eventsSelector,
 		}
@@ -191,6 +192,7 @@ var _ = Describe("#242445", func() {
 				Client:             fakeClient,
 				Namespace:          Namespace,
 				PodContext:         fakePodContext,
+				OpsPodNamespace:    "kube-system",
 				Options:            options,
 				ETCDMainSelector:   mainSelector,
 				ETCDEventsSelector: eventsSelector,
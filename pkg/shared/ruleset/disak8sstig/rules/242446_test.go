@@ -278,11 +278,12 @@ var _ = Describe("#242446", func() {
 	It("should fail when pods cannot be found", func() {
 		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{}, [][]error{})
 		r := &rules.Rule242446{
-			Logger:     testLogger,
-			InstanceID: instanceID,
-			Client:     fakeClient,
-			Namespace:  Namespace,
-			PodContext: fakePodContext,
+			Logger:          testLogger,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			Namespace:       Namespace,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -303,6 +304,7 @@ var _ = Describe("#242446", func() {
 			Client:          fakeClient,
 			Namespace:       Namespace,
 			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 			DeploymentNames: []string{"kube-controller-manager", "kube-scheduler"},
 		}
 
@@ -324,12 +326,13 @@ var _ = Describe("#242446", func() {
 
 			fakePodContext = fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 			r := &rules.Rule242446{
-				Logger:     testLogger,
-				InstanceID: instanceID,
-				Client:     fakeClient,
-				Namespace:  Namespace,
-				PodContext: fakePodContext,
-				Options:    options,
+				Logger:          testLogger,
+				InstanceID:      instanceID,
+				Client:          fakeClient,
+				Namespace:       Namespace,
+				PodContext:      fakePodContext,
+				OpsPodNamespace: "kube-system",
+				Options:         options,
 			}
 
 			ruleResult, err := r.Run(ctx)
@@ -174,6 +174,7 @@ var _ = Describe("#242459", func() {
 			Client:             fakeClient,
 			Namespace:          Namespace,
 			PodContext:         fakePodContext,
+			OpsPodNamespace:    "kube-system",
 			ETCDMainSelector:   mainSelector,
 			ETCDEventsSelector: eventsSelector,
 		}
@@ -202,6 +203,7 @@ var _ = Describe("#242459", func() {
 				Client:             fakeClient,
 				Namespace:          Namespace,
 				PodContext:         fakePodContext,
+				OpsPodNamespace:    "kube-system",
 				ETCDMainSelector:   mainSelector,
 				ETCDEventsSelector: eventsSelector,
 			}
@@ -277,11 +277,12 @@ var _ = Describe("#242460", func() {
 	It("should fail when pods cannot be found", func() {
 		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{}, [][]error{})
 		r := &rules.Rule242460{
-			Logger:     testLogger,
-			InstanceID: instanceID,
-			Client:     fakeClient,
-			Namespace:  Namespace,
-			PodContext: fakePodContext,
+			Logger:          testLogger,
+			InstanceID:      instanceID,
+			Client:          fakeClient,
+			Namespace:       Namespace,
+			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 		}
 
 		ruleResult, err := r.Run(ctx)
@@ -302,6 +303,7 @@ var _ = Describe("#242460", func() {
 			Client:          fakeClient,
 			Namespace:       Namespace,
 			PodContext:      fakePodContext,
+			OpsPodNamespace: "kube-system",
 			DeploymentNames: []string{"kube-controller-manager", "kube-scheduler"},
 		}
 
@@ -323,11 +325,12 @@ var _ = Describe("#242460", func() {
 
 			fakePodContext = fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError)
 			r := &rules.Rule242460{
-				Logger:     testLogger,
-				InstanceID: instanceID,
-				Client:     fakeClient,
-				Namespace:  Namespace,
-				PodContext: fakePodContext,
+				Logger:          testLogger,
+				InstanceID:      instanceID,
+				Client:          fakeClient,
+				Namespace:       Namespace,
+				PodContext:      fakePodContext,
+				OpsPodNamespace: "kube-system",
 			}
 
 			ruleResult, err := r.Run(ctx)
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// RuleDocumentation contains the documentation of rules by rule ID.
+// It can be used to document rules that do not implement [rule.Documented] themselves.
+type RuleDocumentation map[string]rule.Documentation
+
+type documentation struct {
+	Description string      `yaml:"description"`
+	Rationale   string      `yaml:"rationale"`
+	Remediation string      `yaml:"remediation"`
+	References  []reference `yaml:"references"`
+}
+
+type reference struct {
+	Type string `yaml:"type"`
+	ID   string `yaml:"id"`
+	URL  string `yaml:"url"`
+}
+
+// ParseRuleDocumentation parses yaml documentation of rules by rule ID.
+func ParseRuleDocumentation(data []byte) (RuleDocumentation, error) {
+	var documentations map[string]documentation
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&documentations); err != nil {
+		return nil, fmt.Errorf("failed to parse rule documentation: %w", err)
+	}
+
+	ruleDocumentation := make(RuleDocumentation, len(documentations))
+	for ruleID, doc := range documentations {
+		if len(doc.Description) == 0 {
+			return nil, fmt.Errorf("documentation of rule with id %s: description must not be empty", ruleID)
+		}
+
+		ruleDoc := rule.Documentation{
+			Description: doc.Description,
+			Rationale:   doc.Rationale,
+			Remediation: doc.Remediation,
+		}
+		for _, ref := range doc.References {
+			if len(ref.Type) == 0 || len(ref.ID) == 0 {
+				return nil, fmt.Errorf("documentation of rule with id %s: references must have a type and an id", ruleID)
+			}
+			ruleDoc.References = append(ruleDoc.References, rule.Reference{Type: rule.ReferenceType(ref.Type), ID: ref.ID, URL: ref.URL})
+		}
+		ruleDocumentation[ruleID] = ruleDoc
+	}
+	return ruleDocumentation, nil
+}
+
+// MustParseRuleDocumentation parses yaml documentation of rules by rule ID and panics if it is invalid.
+// It is meant to be used with documentation embedded into rulesets.
+func MustParseRuleDocumentation(data []byte) RuleDocumentation {
+	ruleDocumentation, err := ParseRuleDocumentation(data)
+	if err != nil {
+		panic(err)
+	}
+	return ruleDocumentation
+}
+
+// Document sets the documentation of the rule result if it is not already documented.
+func (d RuleDocumentation) Document(result *rule.RuleResult) {
+	if result.Documentation != nil {
+		return
+	}
+
+	if doc, ok := d[result.RuleID]; ok {
+		result.Documentation = &doc
+	}
+}
+
+// DocumentRuleset sets the documentation of all rule results of the ruleset result that are not already documented.
+func (d RuleDocumentation) DocumentRuleset(result *ruleset.RulesetResult) {
+	for idx := range result.RuleResults {
+		d.Document(&result.RuleResults[idx])
+	}
+}
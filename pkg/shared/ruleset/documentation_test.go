@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig"
+)
+
+var _ = Describe("documentation", func() {
+	Describe("#ParseRuleDocumentation", func() {
+		It("should parse rule documentation", func() {
+			data := []byte(`
+"1":
+  description: foo
+  rationale: bar
+  remediation: baz
+  references:
+  - type: STIG Vuln ID
+    id: V-1
+    url: https://example.com
+"2":
+  description: foo
+`)
+			documentation, err := sharedruleset.ParseRuleDocumentation(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(documentation).To(Equal(sharedruleset.RuleDocumentation{
+				"1": {
+					Description: "foo",
+					Rationale:   "bar",
+					Remediation: "baz",
+					References: []rule.Reference{
+						{Type: rule.ReferenceSTIGVulnID, ID: "V-1", URL: "https://example.com"},
+					},
+				},
+				"2": {Description: "foo"},
+			}))
+		})
+
+		DescribeTable("should return errors for invalid documentation",
+			func(data, expectedErr string) {
+				_, err := sharedruleset.ParseRuleDocumentation([]byte(data))
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("unknown field", "\"1\":\n  description: foo\n  foo: bar\n", "field foo not found"),
+			Entry("missing description", "\"1\":\n  rationale: foo\n", "documentation of rule with id 1: description must not be empty"),
+			Entry("reference without id", "\"1\":\n  description: foo\n  references:\n  - type: CCI\n", "documentation of rule with id 1: references must have a type and an id"),
+		)
+
+		It("should parse the embedded DISA Kubernetes STIG documentation", func() {
+			Expect(disak8sstig.Documentation).ToNot(BeEmpty())
+			for ruleID, documentation := range disak8sstig.Documentation {
+				Expect(documentation.References).To(ContainElement(rule.Reference{Type: rule.ReferenceSTIGVulnID, ID: "V-" + ruleID}))
+			}
+		})
+	})
+
+	Describe("#DocumentRuleset", func() {
+		It("should document rule results that are not already documented", func() {
+			var (
+				documentation = sharedruleset.RuleDocumentation{
+					"1": {Description: "foo"},
+					"2": {Description: "bar"},
+				}
+				documented = &rule.Documentation{Description: "baz"}
+				result     = ruleset.RulesetResult{
+					RuleResults: []rule.RuleResult{
+						{RuleID: "1"},
+						{RuleID: "2", Documentation: documented},
+						{RuleID: "3"},
+					},
+				}
+			)
+
+			documentation.DocumentRuleset(&result)
+			Expect(result.RuleResults[0].Documentation).To(Equal(&rule.Documentation{Description: "foo"}))
+			Expect(result.RuleResults[1].Documentation).To(BeIdenticalTo(documented))
+			Expect(result.RuleResults[2].Documentation).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// ValidateExceptions validates the exceptions of a ruleset.
+func ValidateExceptions(exceptions []config.ExceptionConfig) error {
+	var err error
+	for idx, exception := range exceptions {
+		if len(exception.RuleID) == 0 {
+			err = errors.Join(err, fmt.Errorf("exception %d: ruleID must not be empty", idx))
+			continue
+		}
+		if len(exception.Justification) == 0 {
+			err = errors.Join(err, fmt.Errorf("exception %d for rule id %s: justification must not be empty", idx, exception.RuleID))
+		}
+		if exception.ExpiresAt.IsZero() {
+			err = errors.Join(err, fmt.Errorf("exception %d for rule id %s: expiresAt must be set", idx, exception.RuleID))
+		}
+	}
+	return err
+}
+
+// ApplyExceptions sets the status of [rule.Failed] and [rule.Warning] checks matched by an exception that
+// has not expired at the given time to [rule.Accepted]. The justification of the exception is used as check message,
+// followed by the original status and message of the check.
+// Checks matched by expired exceptions keep their original status. The results of the exceptions are set in the ruleset result.
+func ApplyExceptions(result *ruleset.RulesetResult, exceptions []config.ExceptionConfig, now time.Time) {
+	if len(exceptions) == 0 {
+		return
+	}
+
+	exceptionResults := make([]ruleset.ExceptionResult, 0, len(exceptions))
+	for _, exception := range exceptions {
+		exceptionResult := ruleset.ExceptionResult{
+			RuleID:        exception.RuleID,
+			Justification: exception.Justification,
+			Owner:         exception.Owner,
+			Ticket:        exception.Ticket,
+			ExpiresAt:     exception.ExpiresAt,
+		}
+		for _, target := range exception.Targets {
+			exceptionResult.Targets = append(exceptionResult.Targets, rule.Target(maps.Clone(target)))
+		}
+		exceptionResults = append(exceptionResults, exceptionResult)
+	}
+
+	for ruleIdx := range result.RuleResults {
+		for exceptionIdx, matches := range applyRuleExceptions(&result.RuleResults[ruleIdx], exceptions, now) {
+			exceptionResults[exceptionIdx].Matches += matches
+		}
+	}
+
+	for idx := range exceptionResults {
+		switch {
+		case !now.Before(exceptionResults[idx].ExpiresAt):
+			exceptionResults[idx].State = ruleset.ExceptionExpired
+		case exceptionResults[idx].Matches > 0:
+			exceptionResults[idx].State = ruleset.ExceptionActive
+		default:
+			exceptionResults[idx].State = ruleset.ExceptionUnused
+		}
+	}
+	result.Exceptions = exceptionResults
+}
+
+// ApplyRuleExceptions applies the exceptions to the checks of a single rule result in the same way as [ApplyExceptions].
+func ApplyRuleExceptions(result *rule.RuleResult, exceptions []config.ExceptionConfig, now time.Time) {
+	applyRuleExceptions(result, exceptions, now)
+}
+
+// applyRuleExceptions applies the exceptions to the checks of a rule result and
+// returns the number of checks matched by every exception.
+func applyRuleExceptions(result *rule.RuleResult, exceptions []config.ExceptionConfig, now time.Time) []int {
+	matches := make([]int, len(exceptions))
+	for checkIdx, checkResult := range result.CheckResults {
+		if checkResult.Status != rule.Failed && checkResult.Status != rule.Warning {
+			continue
+		}
+
+		accepted := false
+		for exceptionIdx, exception := range exceptions {
+			if exception.RuleID != result.RuleID || !exceptionMatchesTarget(exception, checkResult.Target) {
+				continue
+			}
+
+			matches[exceptionIdx]++
+			if !accepted && now.Before(exception.ExpiresAt) {
+				message := fmt.Sprintf("%s (%s: %s)", exception.Justification, checkResult.Status, checkResult.Message)
+				result.CheckResults[checkIdx] = rule.AcceptedCheckResult(message, checkResult.Target)
+				accepted = true
+			}
+		}
+	}
+	return matches
+}
+
+// exceptionMatchesTarget returns true if the target contains all attributes of any of the exception targets.
+func exceptionMatchesTarget(exception config.ExceptionConfig, target rule.Target) bool {
+	if len(exception.Targets) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(exception.Targets, func(exceptionTarget map[string]string) bool {
+		for key, value := range exceptionTarget {
+			if targetValue, ok := target[key]; !ok || targetValue != value {
+				return false
+			}
+		}
+		return true
+	})
+}
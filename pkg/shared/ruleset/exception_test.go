@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+var _ = Describe("exception", func() {
+	var (
+		now     = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		active  = now.Add(time.Hour)
+		expired = now.Add(-time.Hour)
+	)
+
+	Describe("#ValidateExceptions", func() {
+		It("should accept valid exceptions", func() {
+			Expect(sharedruleset.ValidateExceptions([]config.ExceptionConfig{
+				{RuleID: "1", Justification: "foo", ExpiresAt: active},
+			})).To(Succeed())
+		})
+
+		It("should return errors for invalid exceptions", func() {
+			err := sharedruleset.ValidateExceptions([]config.ExceptionConfig{
+				{Justification: "foo", ExpiresAt: active},
+				{RuleID: "2", ExpiresAt: active},
+				{RuleID: "3", Justification: "foo"},
+			})
+			Expect(err).To(MatchError(ContainSubstring("exception 0: ruleID must not be empty")))
+			Expect(err).To(MatchError(ContainSubstring("exception 1 for rule id 2: justification must not be empty")))
+			Expect(err).To(MatchError(ContainSubstring("exception 2 for rule id 3: expiresAt must be set")))
+		})
+	})
+
+	Describe("#ApplyExceptions", func() {
+		var result ruleset.RulesetResult
+
+		BeforeEach(func() {
+			result = ruleset.RulesetResult{
+				RuleResults: []rule.RuleResult{
+					{
+						RuleID: "1",
+						CheckResults: []rule.CheckResult{
+							rule.FailedCheckResult("failed", rule.NewTarget("name", "foo", "namespace", "bar")),
+							rule.FailedCheckResult("failed", rule.NewTarget("name", "foo", "namespace", "baz")),
+							rule.WarningCheckResult("warning", rule.NewTarget("name", "bar", "namespace", "bar")),
+							rule.PassedCheckResult("passed", rule.NewTarget("name", "baz", "namespace", "bar")),
+							rule.ErroredCheckResult("errored", rule.NewTarget()),
+						},
+					},
+					{
+						RuleID: "2",
+						CheckResults: []rule.CheckResult{
+							rule.FailedCheckResult("failed", rule.NewTarget()),
+						},
+					},
+				},
+			}
+		})
+
+		It("should accept failed and warning checks matching active exceptions", func() {
+			sharedruleset.ApplyExceptions(&result, []config.ExceptionConfig{
+				{RuleID: "1", Targets: []map[string]string{{"namespace": "bar"}}, Justification: "accepted", Owner: "foo", Ticket: "bar", ExpiresAt: active},
+			}, now)
+
+			Expect(result.RuleResults[0].CheckResults).To(Equal([]rule.CheckResult{
+				rule.AcceptedCheckResult("accepted (Failed: failed)", rule.NewTarget("name", "foo", "namespace", "bar")),
+				rule.FailedCheckResult("failed", rule.NewTarget("name", "foo", "namespace", "baz")),
+				rule.AcceptedCheckResult("accepted (Warning: warning)", rule.NewTarget("name", "bar", "namespace", "bar")),
+				rule.PassedCheckResult("passed", rule.NewTarget("name", "baz", "namespace", "bar")),
+				rule.ErroredCheckResult("errored", rule.NewTarget()),
+			}))
+			Expect(result.RuleResults[1].CheckResults[0].Status).To(Equal(rule.Failed))
+			Expect(result.Exceptions).To(Equal([]ruleset.ExceptionResult{
+				{RuleID: "1", Targets: []rule.Target{rule.NewTarget("namespace", "bar")}, Justification: "accepted", Owner: "foo", Ticket: "bar", ExpiresAt: active, State: ruleset.ExceptionActive, Matches: 2},
+			}))
+		})
+
+		It("should match all checks of a rule when no targets are set", func() {
+			sharedruleset.ApplyExceptions(&result, []config.ExceptionConfig{
+				{RuleID: "2", Justification: "accepted", ExpiresAt: active},
+			}, now)
+
+			Expect(result.RuleResults[1].CheckResults).To(Equal([]rule.CheckResult{rule.AcceptedCheckResult("accepted (Failed: failed)", rule.NewTarget())}))
+			Expect(result.Exceptions[0].State).To(Equal(ruleset.ExceptionActive))
+		})
+
+		It("should not accept checks matching expired exceptions", func() {
+			sharedruleset.ApplyExceptions(&result, []config.ExceptionConfig{
+				{RuleID: "2", Justification: "accepted", ExpiresAt: expired},
+				{RuleID: "3", Justification: "accepted", ExpiresAt: expired},
+			}, now)
+
+			Expect(result.RuleResults[1].CheckResults[0].Status).To(Equal(rule.Failed))
+			Expect(result.Exceptions).To(HaveLen(2))
+			Expect(result.Exceptions[0].State).To(Equal(ruleset.ExceptionExpired))
+			Expect(result.Exceptions[0].Matches).To(Equal(1))
+			Expect(result.Exceptions[1].State).To(Equal(ruleset.ExceptionExpired))
+			Expect(result.Exceptions[1].Matches).To(Equal(0))
+		})
+
+		It("should report exceptions that do not match any check as unused", func() {
+			sharedruleset.ApplyExceptions(&result, []config.ExceptionConfig{
+				{RuleID: "1", Targets: []map[string]string{{"name": "foo", "namespace": "foo"}}, Justification: "accepted", ExpiresAt: active},
+			}, now)
+
+			Expect(result.Exceptions[0].State).To(Equal(ruleset.ExceptionUnused))
+			Expect(result.RuleResults[0].CheckResults[0].Status).To(Equal(rule.Failed))
+		})
+
+		It("should accept a check once when it matches multiple exceptions", func() {
+			sharedruleset.ApplyExceptions(&result, []config.ExceptionConfig{
+				{RuleID: "2", Justification: "expired", ExpiresAt: expired},
+				{RuleID: "2", Justification: "first", ExpiresAt: active},
+				{RuleID: "2", Justification: "second", ExpiresAt: active},
+			}, now)
+
+			Expect(result.RuleResults[1].CheckResults).To(Equal([]rule.CheckResult{rule.AcceptedCheckResult("first (Failed: failed)", rule.NewTarget())}))
+			Expect(result.Exceptions[1].Matches).To(Equal(1))
+			Expect(result.Exceptions[2].Matches).To(Equal(1))
+		})
+
+		It("should accept checks until the end of the day of a date only expiresAt", func() {
+			var exceptions []config.ExceptionConfig
+			Expect(yaml.Unmarshal([]byte(`
+- ruleID: "2"
+  justification: accepted
+  expiresAt: 2000-01-01
+- ruleID: "2"
+  justification: accepted
+  expiresAt: 2000-01-01T00:00:00Z
+`), &exceptions)).To(Succeed())
+			Expect(exceptions[0].ExpiresAt).To(Equal(time.Date(2000, time.January, 1, 23, 59, 59, 999999999, time.UTC)))
+			Expect(exceptions[1].ExpiresAt).To(Equal(now))
+
+			sharedruleset.ApplyExceptions(&result, exceptions, now.Add(12*time.Hour))
+
+			Expect(result.RuleResults[1].CheckResults[0].Status).To(Equal(rule.Accepted))
+			Expect(result.Exceptions[0].State).To(Equal(ruleset.ExceptionActive))
+			Expect(result.Exceptions[1].State).To(Equal(ruleset.ExceptionExpired))
+		})
+	})
+
+	Describe("#ApplyRuleExceptions", func() {
+		It("should accept failed and warning checks of a single rule", func() {
+			result := rule.RuleResult{
+				RuleID: "1",
+				CheckResults: []rule.CheckResult{
+					rule.FailedCheckResult("failed", rule.NewTarget("name", "foo")),
+					rule.WarningCheckResult("warning", rule.NewTarget("name", "bar")),
+				},
+			}
+
+			sharedruleset.ApplyRuleExceptions(&result, []config.ExceptionConfig{
+				{RuleID: "1", Targets: []map[string]string{{"name": "foo"}}, Justification: "accepted", ExpiresAt: active},
+				{RuleID: "2", Justification: "accepted", ExpiresAt: active},
+			}, now)
+
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{
+				rule.AcceptedCheckResult("accepted (Failed: failed)", rule.NewTarget("name", "foo")),
+				rule.WarningCheckResult("warning", rule.NewTarget("name", "bar")),
+			}))
+		})
+	})
+})
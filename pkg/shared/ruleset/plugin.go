@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/plugin"
+	"github.com/gardener/diki/pkg/rule/retry"
+)
+
+// PluginRules creates the rules of the plugins configured for a ruleset. Failed plugin executions are retried
+// and the skip and retry pattern options of the plugin rules are applied like for any other rule.
+// The given rest config is passed as kubeconfig to the plugin executables.
+func PluginRules(plugins []config.PluginConfig, restConfig *rest.Config, ruleOptions map[string]config.RuleOptionsConfig, logger *slog.Logger) ([]rule.Rule, error) {
+	if err := validatePlugins(plugins, field.NewPath("plugins")).ToAggregate(); err != nil {
+		return nil, fmt.Errorf("plugins error: %w", err)
+	}
+
+	pluginRules := make([]rule.Rule, 0, len(plugins))
+	for _, pluginConfig := range plugins {
+		var (
+			pluginRule rule.Rule
+			ruleLogger = logger.With("rule_id", pluginConfig.ID)
+			maxRetries = 1
+		)
+		if pluginConfig.MaxRetries != nil {
+			maxRetries = *pluginConfig.MaxRetries
+		}
+		pluginRule = retry.New(
+			retry.WithBaseRule(plugin.NewRule(pluginConfig, restConfig)),
+			retry.WithMaxRetries(maxRetries),
+			retry.WithRetryCondition(plugin.RetryCondition),
+			retry.WithLogger(ruleLogger),
+		)
+
+		opt, found := ruleOptions[pluginConfig.ID]
+		switch {
+		case found && opt.Skip != nil && opt.Skip.Enabled:
+			pluginRule = rule.NewSkipRule(pluginConfig.ID, pluginConfig.Name, opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(rule.SeverityLevel(pluginConfig.Severity)))
+		case found && len(opt.RetryPatterns) > 0:
+			var err error
+			if pluginRule, err = WithRetryPatterns(pluginRule, opt.RetryPatterns); err != nil {
+				return nil, err
+			}
+		}
+		pluginRules = append(pluginRules, pluginRule)
+	}
+	return pluginRules, nil
+}
+
+func validatePlugins(plugins []config.PluginConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		ids     = map[string]struct{}{}
+	)
+
+	for i, pluginConfig := range plugins {
+		idxPath := fldPath.Index(i)
+		if len(pluginConfig.ID) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("id"), "must not be empty"))
+		}
+		if _, ok := ids[pluginConfig.ID]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("id"), pluginConfig.ID))
+		}
+		ids[pluginConfig.ID] = struct{}{}
+		if len(pluginConfig.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must not be empty"))
+		}
+		if !slices.Contains(rule.Severities(), rule.SeverityLevel(pluginConfig.Severity)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("severity"), pluginConfig.Severity, rule.Severities()))
+		}
+		if len(pluginConfig.Command) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("command"), "must not be empty"))
+		}
+		if pluginConfig.MaxRetries != nil && *pluginConfig.MaxRetries < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxRetries"), *pluginConfig.MaxRetries, "must not be less than 0"))
+		}
+	}
+	return allErrs
+}
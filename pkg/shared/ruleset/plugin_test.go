@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset_test
+
+import (
+	"log/slog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/retry"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+var _ = Describe("plugin", func() {
+	Describe("#PluginRules", func() {
+		var (
+			plugins []config.PluginConfig
+			logger  = slog.Default()
+		)
+
+		BeforeEach(func() {
+			plugins = []config.PluginConfig{
+				{ID: "foo", Name: "Foo", Severity: "High", Command: "/foo"},
+				{ID: "bar", Name: "Bar", Severity: "Low", Command: "/bar", MaxRetries: ptr.To(3)},
+			}
+		})
+
+		It("should create retryable plugin rules", func() {
+			pluginRules, err := sharedruleset.PluginRules(plugins, nil, nil, logger)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pluginRules).To(HaveLen(2))
+
+			retryableRule, ok := pluginRules[0].(*retry.RetryableRule)
+			Expect(ok).To(BeTrue())
+			Expect(retryableRule.ID()).To(Equal("foo"))
+			Expect(retryableRule.Severity()).To(Equal(rule.SeverityHigh))
+			Expect(retryableRule.MaxRetries).To(Equal(1))
+			Expect(retryableRule.RetryCondition(rule.RuleResult{CheckResults: []rule.CheckResult{rule.ErroredCheckResult("plugin execution failed: exit status 1", nil)}})).To(BeTrue())
+
+			retryableRule, ok = pluginRules[1].(*retry.RetryableRule)
+			Expect(ok).To(BeTrue())
+			Expect(retryableRule.MaxRetries).To(Equal(3))
+		})
+
+		It("should apply the rule options", func() {
+			ruleOptions := map[string]config.RuleOptionsConfig{
+				"foo": {RuleID: "foo", Skip: &config.RuleOptionSkipConfig{Enabled: true, Justification: "not needed"}},
+				"bar": {RuleID: "bar", RetryPatterns: []string{"^bar"}},
+			}
+
+			pluginRules, err := sharedruleset.PluginRules(plugins, nil, ruleOptions, logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, ok := pluginRules[0].(*rule.SkipRule)
+			Expect(ok).To(BeTrue())
+			Expect(pluginRules[0].(rule.Severity).Severity()).To(Equal(rule.SeverityHigh))
+
+			retryableRule, ok := pluginRules[1].(*retry.RetryableRule)
+			Expect(ok).To(BeTrue())
+			Expect(retryableRule.RetryCondition(rule.RuleResult{CheckResults: []rule.CheckResult{rule.ErroredCheckResult("bar error", nil)}})).To(BeTrue())
+			Expect(retryableRule.RetryCondition(rule.RuleResult{CheckResults: []rule.CheckResult{rule.ErroredCheckResult("plugin execution failed: exit status 1", nil)}})).To(BeTrue())
+			Expect(retryableRule.RetryCondition(rule.RuleResult{CheckResults: []rule.CheckResult{rule.ErroredCheckResult("plugin returned an invalid response", nil)}})).To(BeFalse())
+		})
+
+		It("should return an error for invalid plugin configurations", func() {
+			plugins = append(plugins,
+				config.PluginConfig{ID: "foo", Severity: "Foo", MaxRetries: ptr.To(-1)},
+			)
+
+			_, err := sharedruleset.PluginRules(plugins, nil, nil, logger)
+			Expect(err).To(MatchError(ContainSubstring(`plugins[2].id: Duplicate value: "foo"`)))
+			Expect(err).To(MatchError(ContainSubstring("plugins[2].name: Required value: must not be empty")))
+			Expect(err).To(MatchError(ContainSubstring(`plugins[2].severity: Unsupported value: "Foo"`)))
+			Expect(err).To(MatchError(ContainSubstring("plugins[2].command: Required value: must not be empty")))
+			Expect(err).To(MatchError(ContainSubstring("plugins[2].maxRetries: Invalid value: -1: must not be less than 0")))
+		})
+	})
+})
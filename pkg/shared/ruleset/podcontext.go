@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/shared/provider"
+)
+
+// ClosePodContexts deletes the pods of pooled pod contexts. It is meant to be deferred by ruleset runs
+// and deletes the pods even if the context of the run is already done.
+func ClosePodContexts(ctx context.Context, podContexts []*pod.PooledPodContext, log provider.Logger) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 2*time.Minute)
+	defer cancel()
+
+	for _, podContext := range podContexts {
+		if err := podContext.Close(ctx); err != nil {
+			log.Error("failed to delete pooled ops pods", "error", err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/retry"
+)
+
+// WithRetryPatterns makes a rule retry runs with errored checks that match any of the given regex patterns.
+// The retry condition of a [retry.RetryableRule] is extended, while any other rule is wrapped in a
+// new [retry.RetryableRule] created with the given options.
+func WithRetryPatterns(r rule.Rule, patterns []string, options ...retry.CreateOption) (rule.Rule, error) {
+	if len(patterns) == 0 {
+		return r, nil
+	}
+
+	regexes := make([]regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry pattern %q for rule id %s: %w", pattern, r.ID(), err)
+		}
+		regexes = append(regexes, *regex)
+	}
+
+	retryCondition := retry.RetryConditionFromRegex(regexes...)
+	if retryableRule, ok := r.(*retry.RetryableRule); ok {
+		retryableRule.RetryCondition = retry.AnyRetryCondition(retryableRule.RetryCondition, retryCondition)
+		return retryableRule, nil
+	}
+
+	options = append([]retry.CreateOption{retry.WithBaseRule(r), retry.WithRetryCondition(retryCondition)}, options...)
+	return retry.New(options...), nil
+}
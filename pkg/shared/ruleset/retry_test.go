@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/retry"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+var _ = Describe("retry", func() {
+	Describe("#WithRetryPatterns", func() {
+		var (
+			fooResult = rule.RuleResult{CheckResults: []rule.CheckResult{rule.ErroredCheckResult("foo error", rule.NewTarget())}}
+			barResult = rule.RuleResult{CheckResults: []rule.CheckResult{rule.ErroredCheckResult("bar error", rule.NewTarget())}}
+		)
+
+		It("should return the same rule when no patterns are set", func() {
+			r := &fakeRule{id: "1"}
+			res, err := sharedruleset.WithRetryPatterns(r, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeIdenticalTo(r))
+		})
+
+		It("should wrap rules that are not retryable", func() {
+			res, err := sharedruleset.WithRetryPatterns(&fakeRule{id: "1"}, []string{"^foo"}, retry.WithMaxRetries(3))
+			Expect(err).NotTo(HaveOccurred())
+
+			retryableRule, ok := res.(*retry.RetryableRule)
+			Expect(ok).To(BeTrue())
+			Expect(retryableRule.MaxRetries).To(Equal(3))
+			Expect(retryableRule.RetryCondition(fooResult)).To(BeTrue())
+			Expect(retryableRule.RetryCondition(barResult)).To(BeFalse())
+		})
+
+		It("should extend the retry condition of retryable rules", func() {
+			r := retry.New(
+				retry.WithBaseRule(&fakeRule{id: "1"}),
+				retry.WithMaxRetries(2),
+				retry.WithRetryCondition(func(ruleResult rule.RuleResult) bool {
+					return ruleResult.CheckResults[0].Message == "foo error"
+				}),
+			)
+
+			res, err := sharedruleset.WithRetryPatterns(r, []string{"^bar"}, retry.WithMaxRetries(5))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeIdenticalTo(r))
+			Expect(r.MaxRetries).To(Equal(2))
+			Expect(r.RetryCondition(fooResult)).To(BeTrue())
+			Expect(r.RetryCondition(barResult)).To(BeTrue())
+		})
+
+		It("should return an error for invalid patterns", func() {
+			_, err := sharedruleset.WithRetryPatterns(&fakeRule{id: "1"}, []string{"("}, retry.WithMaxRetries(3))
+			Expect(err).To(MatchError(ContainSubstring("invalid retry pattern \"(\" for rule id 1")))
+		})
+	})
+})
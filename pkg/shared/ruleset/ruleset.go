@@ -16,11 +16,16 @@ import (
 )
 
 // Run is a sample implementation for a [ruleset.Ruleset].
+// Rules that return an error are reported with a single [rule.Errored] check
+// containing the error message. Each rule run is bound by its timeout from timeouts.
+// The returned result always contains the results of all finished rules,
+// even when a non-nil error is returned.
 func Run(
 	ctx context.Context,
 	r ruleset.Ruleset,
 	rules map[string]rule.Rule,
 	numWorkers int,
+	timeouts RuleTimeouts,
 	log provider.Logger,
 ) (ruleset.RulesetResult, error) {
 	if len(rules) == 0 {
@@ -54,7 +59,10 @@ func Run(
 		go func() {
 			for r := range rulesCh {
 				log.Info("starting rule run", "rule_id", r.ID())
-				res, err := r.Run(ctx)
+				res, err := RunRule(ctx, r, timeouts.Timeout(r.ID()), log)
+				if err != nil {
+					res = ErroredRuleResult(r, err)
+				}
 				res.RuleID = r.ID()
 				res.RuleName = r.Name()
 
@@ -96,17 +104,19 @@ func Run(
 			err = errors.Join(err, fmt.Errorf("rule with id %s errored: %w", run.result.RuleID, run.err))
 		} else {
 			log.Info(finishMsg, "rule_id", run.result.RuleID, "remaining", remaining)
-			result.RuleResults = append(result.RuleResults, run.result)
 		}
+		result.RuleResults = append(result.RuleResults, run.result)
 	}
 
-	if err := ctx.Err(); err != nil {
-		return ruleset.RulesetResult{}, err
+	if ctx.Err() != nil {
+		err = errors.Join(err, context.Cause(ctx))
 	}
 
-	// TODO: maybe return both result and err
-	if err != nil {
-		return ruleset.RulesetResult{}, err
-	}
-	return result, nil
+	return result, err
+}
+
+// ErroredRuleResult returns a [rule.RuleResult] for a rule whose run returned an error.
+// The result contains a single [rule.Errored] check with the error message.
+func ErroredRuleResult(r rule.Rule, err error) rule.RuleResult {
+	return rule.Result(r, rule.ErroredCheckResult(fmt.Sprintf("rule run errored: %s", err.Error()), rule.NewTarget()))
 }
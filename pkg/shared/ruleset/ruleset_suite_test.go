@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset_test
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var testLogger *slog.Logger
+
+func TestRuleset(t *testing.T) {
+	handler := slog.NewJSONHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelInfo})
+	testLogger = slog.New(handler)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shared Ruleset Test Suite")
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset_test
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+var _ ruleset.Ruleset = &fakeRuleset{}
+
+type fakeRuleset struct{}
+
+func (*fakeRuleset) ID() string {
+	return "foo"
+}
+
+func (*fakeRuleset) Name() string {
+	return "Foo"
+}
+
+func (*fakeRuleset) Version() string {
+	return "v1"
+}
+
+func (*fakeRuleset) Run(context.Context) (ruleset.RulesetResult, error) {
+	return ruleset.RulesetResult{}, nil
+}
+
+func (*fakeRuleset) RunRule(context.Context, string) (rule.RuleResult, error) {
+	return rule.RuleResult{}, nil
+}
+
+var (
+	_ rule.Rule     = &fakeRule{}
+	_ rule.Severity = &fakeRule{}
+)
+
+type fakeRule struct {
+	id       string
+	result   rule.RuleResult
+	err      error
+	duration time.Duration
+}
+
+func (r *fakeRule) ID() string {
+	return r.id
+}
+
+func (r *fakeRule) Name() string {
+	return "Rule " + r.id
+}
+
+func (r *fakeRule) Severity() rule.SeverityLevel {
+	return rule.SeverityHigh
+}
+
+func (r *fakeRule) Run(ctx context.Context) (rule.RuleResult, error) {
+	select {
+	case <-time.After(r.duration):
+		return r.result, r.err
+	case <-ctx.Done():
+		return rule.Result(r, rule.ErroredCheckResult(ctx.Err().Error(), rule.NewTarget())), nil
+	}
+}
+
+var _ = Describe("ruleset", func() {
+	Describe("#Run", func() {
+		var (
+			ctx = context.TODO()
+			rs  = &fakeRuleset{}
+		)
+
+		It("should return an error when no rules are registered", func() {
+			_, err := sharedruleset.Run(ctx, rs, map[string]rule.Rule{}, 1, sharedruleset.RuleTimeouts{}, testLogger)
+			Expect(err).To(MatchError("no rules are registered in the ruleset"))
+		})
+
+		It("should keep the results of successful rules when a rule errors", func() {
+			passed := &fakeRule{id: "1"}
+			passed.result = rule.Result(passed, rule.PassedCheckResult("foo", rule.NewTarget()))
+			errored := &fakeRule{id: "2", err: errors.New("bar")}
+
+			res, err := sharedruleset.Run(ctx, rs, map[string]rule.Rule{"1": passed, "2": errored}, 2, sharedruleset.RuleTimeouts{}, testLogger)
+			Expect(err).To(MatchError(ContainSubstring("rule with id 2 errored: bar")))
+
+			slices.SortFunc(res.RuleResults, func(a, b rule.RuleResult) int {
+				return cmp.Compare(a.RuleID, b.RuleID)
+			})
+			Expect(res.RulesetID).To(Equal("foo"))
+			Expect(res.RuleResults).To(Equal([]rule.RuleResult{
+				{
+					RuleID:       "1",
+					RuleName:     "Rule 1",
+					Severity:     rule.SeverityHigh,
+					CheckResults: []rule.CheckResult{rule.PassedCheckResult("foo", rule.NewTarget())},
+				},
+				{
+					RuleID:       "2",
+					RuleName:     "Rule 2",
+					Severity:     rule.SeverityHigh,
+					CheckResults: []rule.CheckResult{rule.ErroredCheckResult("rule run errored: bar", rule.NewTarget())},
+				},
+			}))
+		})
+
+		It("should report rules that exceed their timeout as errored", func() {
+			fast := &fakeRule{id: "1", duration: time.Millisecond}
+			fast.result = rule.Result(fast, rule.PassedCheckResult("foo", rule.NewTarget()))
+			slow := &fakeRule{id: "2", duration: time.Minute}
+			timeouts := sharedruleset.RuleTimeouts{
+				Default: time.Second,
+				Rules:   map[string]time.Duration{"2": 10 * time.Millisecond},
+			}
+
+			res, err := sharedruleset.Run(ctx, rs, map[string]rule.Rule{"1": fast, "2": slow}, 2, timeouts, testLogger)
+			Expect(err).NotTo(HaveOccurred())
+
+			slices.SortFunc(res.RuleResults, func(a, b rule.RuleResult) int {
+				return cmp.Compare(a.RuleID, b.RuleID)
+			})
+			Expect(res.RuleResults[0].CheckResults).To(Equal([]rule.CheckResult{rule.PassedCheckResult("foo", rule.NewTarget())}))
+			Expect(res.RuleResults[1].CheckResults).To(Equal([]rule.CheckResult{rule.ErroredCheckResult("rule run timed out after 10ms", rule.NewTarget())}))
+		})
+	})
+
+	Describe("#NewRuleTimeouts", func() {
+		It("should use the rule specific timeout over the default one", func() {
+			timeouts, err := sharedruleset.NewRuleTimeouts(&metav1.Duration{Duration: time.Minute}, map[string]config.RuleOptionsConfig{
+				"1": {RuleID: "1", Timeout: &metav1.Duration{Duration: time.Hour}},
+				"2": {RuleID: "2"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timeouts.Timeout("1")).To(Equal(time.Hour))
+			Expect(timeouts.Timeout("2")).To(Equal(time.Minute))
+			Expect(timeouts.Timeout("3")).To(Equal(time.Minute))
+		})
+
+		It("should not bound rule runs when no timeouts are configured", func() {
+			timeouts, err := sharedruleset.NewRuleTimeouts(nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timeouts.Timeout("1")).To(BeZero())
+		})
+
+		It("should return an error for negative timeouts", func() {
+			_, err := sharedruleset.NewRuleTimeouts(nil, map[string]config.RuleOptionsConfig{
+				"1": {RuleID: "1", Timeout: &metav1.Duration{Duration: -time.Hour}},
+			})
+			Expect(err).To(MatchError("timeout for rule id 1 should not be a negative duration"))
+		})
+	})
+})
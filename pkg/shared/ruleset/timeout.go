@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/provider"
+)
+
+// cleanupGracePeriod is the time given to a timed out rule
+// to return and clean up the resources it has created, e.g. ops pods.
+var cleanupGracePeriod = time.Minute
+
+// RuleTimeouts contains the timeouts that bound single rule runs.
+type RuleTimeouts struct {
+	// Default is the timeout of rules without a rule specific timeout.
+	// A zero value means that rule runs are not bound.
+	Default time.Duration
+	// Rules contains rule specific timeouts by rule id.
+	Rules map[string]time.Duration
+}
+
+// NewRuleTimeouts creates RuleTimeouts from a ruleset wide default timeout and per rule options.
+func NewRuleTimeouts(defaultTimeout *metav1.Duration, ruleOptions map[string]config.RuleOptionsConfig) (RuleTimeouts, error) {
+	timeouts := RuleTimeouts{
+		Rules: map[string]time.Duration{},
+	}
+
+	if defaultTimeout != nil {
+		if defaultTimeout.Duration < 0 {
+			return RuleTimeouts{}, errors.New("rule timeout should not be a negative duration")
+		}
+		timeouts.Default = defaultTimeout.Duration
+	}
+
+	for ruleID, opt := range ruleOptions {
+		if opt.Timeout == nil {
+			continue
+		}
+		if opt.Timeout.Duration < 0 {
+			return RuleTimeouts{}, fmt.Errorf("timeout for rule id %s should not be a negative duration", ruleID)
+		}
+		timeouts.Rules[ruleID] = opt.Timeout.Duration
+	}
+
+	return timeouts, nil
+}
+
+// Timeout returns the timeout of the rule with the given id.
+func (t RuleTimeouts) Timeout(ruleID string) time.Duration {
+	if timeout, ok := t.Rules[ruleID]; ok {
+		return timeout
+	}
+	return t.Default
+}
+
+// RunRule executes a rule and bounds its run by the given timeout.
+// A rule that does not finish in time is reported with a single [rule.Errored] check.
+// A zero timeout means that the rule run is not bound.
+// The rule run is cancelled on timeout and given [cleanupGracePeriod] to return. A rule that ignores the
+// cancellation is abandoned: its goroutine keeps running and resources it created, e.g. ops pods, might be
+// left behind. An error with the id of the abandoned rule is logged in this case.
+func RunRule(ctx context.Context, r rule.Rule, timeout time.Duration, log provider.Logger) (rule.RuleResult, error) {
+	if timeout <= 0 {
+		return r.Run(ctx)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type run struct {
+		result rule.RuleResult
+		err    error
+	}
+
+	runCh := make(chan run, 1)
+	go func() {
+		res, err := r.Run(timeoutCtx)
+		runCh <- run{result: res, err: err}
+	}()
+
+	select {
+	case run := <-runCh:
+		if timeoutCtx.Err() == nil || ctx.Err() != nil {
+			return run.result, run.err
+		}
+	case <-timeoutCtx.Done():
+		if ctx.Err() != nil {
+			return rule.RuleResult{}, context.Cause(ctx)
+		}
+
+		// the rule run is cancelled, give it time to return so that deferred cleanups can finish
+		select {
+		case <-runCh:
+		case <-time.After(cleanupGracePeriod):
+			log.Error("rule did not return after timeout and is left running, resources it created might not be cleaned up", "rule_id", r.ID(), "grace_period", cleanupGracePeriod.String())
+		}
+	}
+
+	return rule.Result(r, rule.ErroredCheckResult(fmt.Sprintf("rule run timed out after %s", timeout), rule.NewTarget())), nil
+}